@@ -0,0 +1,6 @@
+# List all the plugins loaded by the provider.
+data "goplugin_plugins" "all" {}
+
+output "plugins" {
+  value = { for p in data.goplugin_plugins.all.plugins : p.plugin_id => "${p.info.name} ${p.info.version} (${p.source.index})" }
+}
@@ -12,6 +12,7 @@ provider "goplugin" {
     "github_gist" : {
       source_code   = { dir = "plugins/resource_gist" }
       configuration = jsonencode({}) // gh token loaded from `TF_GITHUB_TOKEN`
+      inherit_env   = ["TF_GITHUB_TOKEN"]
     }
   }
 }
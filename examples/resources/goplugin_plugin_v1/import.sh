@@ -6,3 +6,6 @@ terraform import goplugin_plugin_v1.myresource ${PLUGIN_ID}/${RESOURCE_ID}
 # - We have a resource managed by this plugin with the ID `04fb1aafb3074664089990ea793cb245`.
 terraform import goplugin_plugin_v1.myresource github_gist/04fb1aafb3074664089990ea793cb245
 
+# Plugins implementing the optional `ResourceImporter` interface can accept
+# their own import ID formats (e.g: `owner:name`) after the plugin ID.
+terraform import goplugin_plugin_v1.myresource github_repo/slok:terraform-provider-goplugin
@@ -11,6 +11,8 @@ provider "goplugin" {
     "murmur3" : {
       source_code   = { dir = "./plugins/murmur3" }
       configuration = jsonencode({})
+      // Murmur3 library uses unsafe.
+      sandbox = { allow_unsafe = true }
     }
 
     "ulid" : {
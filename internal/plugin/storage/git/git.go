@@ -5,6 +5,7 @@ import (
 	"io/fs"
 	"path"
 	"strings"
+	"sync"
 	"testing/fstest"
 
 	"github.com/go-git/go-billy/v5"
@@ -94,17 +95,45 @@ func NewSourceCodeRepository(config SourceCodeRepositoryConfig) (storage.SourceC
 // be aware that branch refs can change, however we know that plugins are loaded
 // per Terraform execution on the provider setup, so its ok to cache anything.
 //
-// Note: We don't care about concurrent usage of the map.
-var pluginFsCache = map[string]billy.Filesystem{}
+// Concurrent clones of the same repository reference are deduplicated, failed clones
+// are not cached so they can be retried.
+var (
+	pluginFsCache   = map[string]*repositoryClone{}
+	pluginFsCacheMu sync.Mutex
+)
+
+type repositoryClone struct {
+	done chan struct{}
+	fs   billy.Filesystem
+	err  error
+}
 
 func getRepositoryOnFilesystem(config SourceCodeRepositoryConfig) (billy.Filesystem, error) {
-	// Try first from cache.
+	// Try first from cache (or wait for the clone in progress).
 	id := fmt.Sprintf("%s-%s", config.URL, config.BranchOrTag)
-	fs, ok := pluginFsCache[id]
+	pluginFsCacheMu.Lock()
+	clone, ok := pluginFsCache[id]
 	if ok {
-		return fs, nil
+		pluginFsCacheMu.Unlock()
+		<-clone.done
+		return clone.fs, clone.err
 	}
+	clone = &repositoryClone{done: make(chan struct{})}
+	pluginFsCache[id] = clone
+	pluginFsCacheMu.Unlock()
+
+	clone.fs, clone.err = cloneRepository(config)
+	if clone.err != nil {
+		pluginFsCacheMu.Lock()
+		delete(pluginFsCache, id)
+		pluginFsCacheMu.Unlock()
+	}
+	close(clone.done)
+
+	return clone.fs, clone.err
+}
 
+func cloneRepository(config SourceCodeRepositoryConfig) (billy.Filesystem, error) {
 	// We will try to clone in tag and branch order.
 	possibleRefs := []plumbing.ReferenceName{
 		plumbing.NewTagReferenceName(config.BranchOrTag),
@@ -132,8 +161,6 @@ func getRepositoryOnFilesystem(config SourceCodeRepositoryConfig) (billy.Filesys
 			Auth:          auth,
 		})
 		if err == nil {
-			// Store in cache.
-			pluginFsCache[id] = memfs
 			return memfs, nil
 		}
 	}
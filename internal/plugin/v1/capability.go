@@ -0,0 +1,262 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+// Capability is an optional `apiv1` interface that a plugin can implement.
+type Capability uint
+
+const (
+	// CapabilityResourcePlanner is the `apiv1.ResourcePlanner` interface.
+	CapabilityResourcePlanner Capability = 1 << iota
+	// CapabilityAttributesValidator is the `apiv1.AttributesValidator` interface.
+	CapabilityAttributesValidator
+	// CapabilityResourceImporter is the `apiv1.ResourceImporter` interface.
+	CapabilityResourceImporter
+	// CapabilityAttributesUpgrader is the `apiv1.AttributesUpgrader` interface.
+	CapabilityAttributesUpgrader
+	// CapabilityAttributesComparator is the `apiv1.AttributesComparator` interface.
+	CapabilityAttributesComparator
+	// CapabilityHealthChecker is the `apiv1.HealthChecker` interface.
+	CapabilityHealthChecker
+	// CapabilityCloser is the `apiv1.Closer` interface.
+	CapabilityCloser
+	// CapabilityInfoProvider is the `apiv1.InfoProvider` interface.
+	CapabilityInfoProvider
+)
+
+// Supports returns true if the plugin implements the optional interface of the capability.
+//
+// The plugins returned by the engine implement all the optional interfaces methods, so the users must check
+// the capability before using an optional interface, instead of a type assertion.
+func Supports(plugin interface{}, c Capability) bool {
+	return capabilitiesOf(plugin)&c == c
+}
+
+// capabilitiesOf returns the capabilities of a plugin, the engine plugins know their capabilities, for any
+// other plugin (e.g: the Yaegi interpreted plugins) they are the optional interfaces that it implements.
+func capabilitiesOf(plugin interface{}) Capability {
+	if p, ok := plugin.(interface{ capabilities() Capability }); ok {
+		return p.capabilities()
+	}
+
+	return methodsOf(plugin).capabilities()
+}
+
+// pluginMethods has the methods of the optional interfaces of a plugin, a method is nil if the plugin
+// doesn't implement its optional interface.
+//
+// The Yaegi interpreted plugins only keep the optional interfaces methods when the plugin factory returns
+// them because of the composed interface wrappers (check `yaegicustom.MapTypes`), so the methods are
+// obtained once from the returned plugin.
+type pluginMethods struct {
+	PlanResource       func(context.Context, apiv1.PlanResourceRequest) (*apiv1.PlanResourceResponse, error)
+	ValidateAttributes func(context.Context, apiv1.ValidateAttributesRequest) (*apiv1.ValidateAttributesResponse, error)
+	ImportResource     func(context.Context, apiv1.ImportResourceRequest) (*apiv1.ImportResourceResponse, error)
+	AttributesVersion  func() int
+	UpgradeAttributes  func(context.Context, apiv1.UpgradeAttributesRequest) (*apiv1.UpgradeAttributesResponse, error)
+	AttributesEqual    func(context.Context, apiv1.AttributesEqualRequest) (*apiv1.AttributesEqualResponse, error)
+	HealthCheck        func(context.Context, apiv1.HealthCheckRequest) (*apiv1.HealthCheckResponse, error)
+	Close              func(context.Context) error
+	Info               func() apiv1.PluginInfo
+}
+
+func methodsOf(plugin interface{}) pluginMethods {
+	var m pluginMethods
+	if p, ok := plugin.(apiv1.ResourcePlanner); ok {
+		m.PlanResource = p.PlanResource
+	}
+	if p, ok := plugin.(apiv1.AttributesValidator); ok {
+		m.ValidateAttributes = p.ValidateAttributes
+	}
+	if p, ok := plugin.(apiv1.ResourceImporter); ok {
+		m.ImportResource = p.ImportResource
+	}
+	if p, ok := plugin.(apiv1.AttributesUpgrader); ok {
+		m.AttributesVersion = p.AttributesVersion
+		m.UpgradeAttributes = p.UpgradeAttributes
+	}
+	if p, ok := plugin.(apiv1.AttributesComparator); ok {
+		m.AttributesEqual = p.AttributesEqual
+	}
+	if p, ok := plugin.(apiv1.HealthChecker); ok {
+		m.HealthCheck = p.HealthCheck
+	}
+	if p, ok := plugin.(apiv1.Closer); ok {
+		m.Close = p.Close
+	}
+	if p, ok := plugin.(apiv1.InfoProvider); ok {
+		m.Info = p.Info
+	}
+
+	return m
+}
+
+func (m pluginMethods) capabilities() Capability {
+	var c Capability
+	if m.PlanResource != nil {
+		c |= CapabilityResourcePlanner
+	}
+	if m.ValidateAttributes != nil {
+		c |= CapabilityAttributesValidator
+	}
+	if m.ImportResource != nil {
+		c |= CapabilityResourceImporter
+	}
+	if m.AttributesVersion != nil && m.UpgradeAttributes != nil {
+		c |= CapabilityAttributesUpgrader
+	}
+	if m.AttributesEqual != nil {
+		c |= CapabilityAttributesComparator
+	}
+	if m.HealthCheck != nil {
+		c |= CapabilityHealthChecker
+	}
+	if m.Close != nil {
+		c |= CapabilityCloser
+	}
+	if m.Info != nil {
+		c |= CapabilityInfoProvider
+	}
+
+	return c
+}
+
+// only removes the methods of the capabilities that are not in caps.
+func (m pluginMethods) only(caps Capability) pluginMethods {
+	if caps&CapabilityResourcePlanner == 0 {
+		m.PlanResource = nil
+	}
+	if caps&CapabilityAttributesValidator == 0 {
+		m.ValidateAttributes = nil
+	}
+	if caps&CapabilityResourceImporter == 0 {
+		m.ImportResource = nil
+	}
+	if caps&CapabilityAttributesUpgrader == 0 {
+		m.AttributesVersion = nil
+		m.UpgradeAttributes = nil
+	}
+	if caps&CapabilityAttributesComparator == 0 {
+		m.AttributesEqual = nil
+	}
+	if caps&CapabilityHealthChecker == 0 {
+		m.HealthCheck = nil
+	}
+	if caps&CapabilityCloser == 0 {
+		m.Close = nil
+	}
+	if caps&CapabilityInfoProvider == 0 {
+		m.Info = nil
+	}
+
+	return m
+}
+
+// pluginWrapper implements all the optional interfaces of a plugin, calling the plugin methods only when it
+// supports the capability, this way the engine uses a single plugin type regardless of the optional
+// interfaces that the plugin implements.
+type pluginWrapper struct {
+	methods pluginMethods
+}
+
+func newPluginWrapper(plugin interface{}, caps Capability) pluginWrapper {
+	return pluginWrapper{methods: methodsOf(plugin).only(caps)}
+}
+
+func (p pluginWrapper) capabilities() Capability { return p.methods.capabilities() }
+
+func errUnsupported(iface string) error {
+	return fmt.Errorf("plugin doesn't implement the optional %q interface", iface)
+}
+
+func (p pluginWrapper) PlanResource(ctx context.Context, r apiv1.PlanResourceRequest) (*apiv1.PlanResourceResponse, error) {
+	if p.methods.PlanResource == nil {
+		return nil, errUnsupported("ResourcePlanner")
+	}
+	return p.methods.PlanResource(ctx, r)
+}
+
+func (p pluginWrapper) ValidateAttributes(ctx context.Context, r apiv1.ValidateAttributesRequest) (*apiv1.ValidateAttributesResponse, error) {
+	if p.methods.ValidateAttributes == nil {
+		return nil, errUnsupported("AttributesValidator")
+	}
+	return p.methods.ValidateAttributes(ctx, r)
+}
+
+func (p pluginWrapper) ImportResource(ctx context.Context, r apiv1.ImportResourceRequest) (*apiv1.ImportResourceResponse, error) {
+	if p.methods.ImportResource == nil {
+		return nil, errUnsupported("ResourceImporter")
+	}
+	return p.methods.ImportResource(ctx, r)
+}
+
+// AttributesVersion returns 0 if the plugin doesn't support upgrading the attributes.
+func (p pluginWrapper) AttributesVersion() int {
+	if p.methods.AttributesVersion == nil {
+		return 0
+	}
+	return p.methods.AttributesVersion()
+}
+
+func (p pluginWrapper) UpgradeAttributes(ctx context.Context, r apiv1.UpgradeAttributesRequest) (*apiv1.UpgradeAttributesResponse, error) {
+	if p.methods.UpgradeAttributes == nil {
+		return nil, errUnsupported("AttributesUpgrader")
+	}
+	return p.methods.UpgradeAttributes(ctx, r)
+}
+
+func (p pluginWrapper) AttributesEqual(ctx context.Context, r apiv1.AttributesEqualRequest) (*apiv1.AttributesEqualResponse, error) {
+	if p.methods.AttributesEqual == nil {
+		return nil, errUnsupported("AttributesComparator")
+	}
+	return p.methods.AttributesEqual(ctx, r)
+}
+
+func (p pluginWrapper) HealthCheck(ctx context.Context, r apiv1.HealthCheckRequest) (*apiv1.HealthCheckResponse, error) {
+	if p.methods.HealthCheck == nil {
+		return nil, errUnsupported("HealthChecker")
+	}
+	return p.methods.HealthCheck(ctx, r)
+}
+
+// Close does nothing if the plugin doesn't need to be closed.
+func (p pluginWrapper) Close(ctx context.Context) error {
+	if p.methods.Close == nil {
+		return nil
+	}
+	return p.methods.Close(ctx)
+}
+
+// Info returns empty metadata if the plugin doesn't provide it.
+func (p pluginWrapper) Info() apiv1.PluginInfo {
+	if p.methods.Info == nil {
+		return apiv1.PluginInfo{}
+	}
+	return p.methods.Info()
+}
+
+type resourcePlugin struct {
+	apiv1.ResourcePlugin
+	pluginWrapper
+}
+
+// newResourcePlugin wraps a resource plugin with its capabilities.
+func newResourcePlugin(plugin apiv1.ResourcePlugin, caps Capability) apiv1.ResourcePlugin {
+	return resourcePlugin{ResourcePlugin: plugin, pluginWrapper: newPluginWrapper(plugin, caps)}
+}
+
+type dataSourcePlugin struct {
+	apiv1.DataSourcePlugin
+	pluginWrapper
+}
+
+// newDataSourcePlugin wraps a data source plugin with its capabilities, the resource capabilities are ignored.
+func newDataSourcePlugin(plugin apiv1.DataSourcePlugin, caps Capability) apiv1.DataSourcePlugin {
+	caps &= CapabilityAttributesValidator | CapabilityHealthChecker | CapabilityCloser | CapabilityInfoProvider
+	return dataSourcePlugin{DataSourcePlugin: plugin, pluginWrapper: newPluginWrapper(plugin, caps)}
+}
@@ -0,0 +1,17 @@
+package v1
+
+// Internal helpers exported only for the unit tests.
+var (
+	OpenFileBeneath  = openFileBeneath
+	LstatBeneath     = lstatBeneath
+	ReadlinkBeneath  = readlinkBeneath
+	MkdirBeneath     = mkdirBeneath
+	MkdirAllBeneath  = mkdirAllBeneath
+	RemoveBeneath    = removeBeneath
+	RemoveAllBeneath = removeAllBeneath
+	RenameBeneath    = renameBeneath
+	ChmodBeneath     = chmodBeneath
+	ChownBeneath     = chownBeneath
+	ChtimesBeneath   = chtimesBeneath
+	TruncateBeneath  = truncateBeneath
+)
@@ -0,0 +1,896 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"math/rand"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+// FilesystemPolicy restricts the host filesystem access of a plugin.
+//
+// The policy is enforced on the path based functions of `os`, `io/ioutil`, `path/filepath` and `go/parser` packages
+// (and `io/fs` using `os.DirFS`), and on the `os.File` methods. The standard library packages that open host paths
+// on their own are denied (`text/template`, `html/template`, `go/build` and `go/importer`), and so are their
+// functions on the other packages (e.g: `zip.OpenReader`, `elf.Open`, `http.Dir`). The policy doesn't apply to
+// the network (e.g: unix sockets) nor to the temporary files of `multipart.Reader.ReadForm`. With a root, the plugin paths are relative to the root
+// (e.g: `/data/file` will be `<root>/data/file`), the paths and symlinks that escape the root are denied, and the
+// plugin can't create links nor change the working directory. With any policy, the plugin can't start processes
+// (these would not have the policy). With a root, the files are opened and the path operations (e.g: `os.Remove`,
+// `os.Rename`) are done from the root without following the symlinks once the path has been checked (on Linux,
+// macOS and FreeBSD), so a path can't be replaced with a symlink that escapes the root after checking it, and
+// `os.Chmod` requires the file to be readable or writable.
+//
+// The `os.File` of the plugin is a different type than the host one, so it can't be used on the standard
+// library fields that require the host type (e.g: `exec.Cmd.ExtraFiles`).
+type FilesystemPolicy struct {
+	// Name identifies the plugin on the denied operation errors.
+	Name string
+	// Root is the host directory the plugin paths are relative to, if empty the plugin can access all the host
+	// filesystem.
+	Root string
+	// ReadOnly denies all the filesystem write operations.
+	ReadOnly bool
+}
+
+func (f FilesystemPolicy) enabled() bool {
+	return f.Root != "" || f.ReadOnly
+}
+
+// filesystemDeniedImports are the standard library packages that access the host filesystem using methods that
+// can't be replaced (e.g: `(*template.Template).ParseFiles`), these are denied with a filesystem policy.
+var filesystemDeniedImports = []string{"text/template", "html/template", "go/build", "go/importer"}
+
+// filesystemDeniedSymbols are the standard library functions and types that access the host filesystem, these are
+// removed with a filesystem policy.
+var filesystemDeniedSymbols = map[string][]string{
+	"archive/zip/zip":           {"OpenReader"},
+	"crypto/tls/tls":            {"LoadX509KeyPair"},
+	"debug/buildinfo/buildinfo": {"ReadFile"},
+	"debug/elf/elf":             {"Open"},
+	"debug/macho/macho":         {"Open", "OpenFat"},
+	"debug/pe/pe":               {"Open"},
+	"debug/plan9obj/plan9obj":   {"Open"},
+	"go/parser/parser":          {"ParseDir"},
+	"net/http/http":             {"Dir", "ServeFile"},
+}
+
+// sandbox returns the sandbox policy of the plugin with the packages that would bypass the filesystem policy denied.
+func (f FilesystemPolicy) sandbox(s SandboxPolicy) SandboxPolicy {
+	if !f.enabled() {
+		return s
+	}
+
+	s.DeniedImports = append(append([]string{}, s.DeniedImports...), filesystemDeniedImports...)
+
+	return s
+}
+
+func (f FilesystemPolicy) index() string {
+	if !f.enabled() {
+		return ""
+	}
+
+	return fmt.Sprintf("%q%q%t", f.Name, f.Root, f.ReadOnly)
+}
+
+const virtualTempDir = "/tmp"
+
+// filesystemGuard translates and checks the plugin filesystem operations against the filesystem policy.
+type filesystemGuard struct {
+	name         string
+	root         string
+	resolvedRoot string
+	readOnly     bool
+}
+
+func newFilesystemGuard(policy FilesystemPolicy) (*filesystemGuard, error) {
+	g := &filesystemGuard{name: policy.Name, readOnly: policy.ReadOnly}
+	if policy.Root == "" {
+		return g, nil
+	}
+
+	root, err := filepath.Abs(policy.Root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root: %w", err)
+	}
+
+	resolvedRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root: %w", err)
+	}
+
+	info, err := os.Stat(resolvedRoot)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("invalid root: %q is not a directory", root)
+	}
+
+	g.root = root
+	g.resolvedRoot = resolvedRoot
+
+	return g, nil
+}
+
+func (g *filesystemGuard) denied(op, name, reason string) error {
+	return &fs.PathError{Op: op, Path: name, Err: fmt.Errorf("%s by plugin %q filesystem policy: %w", reason, g.name, fs.ErrPermission)}
+}
+
+// hostPath returns the host path of a plugin path, it will fail if the path escapes the filesystem root.
+func (g *filesystemGuard) hostPath(op, name string) (string, error) {
+	if g.root == "" {
+		return name, nil
+	}
+
+	// Check the path doesn't escape the root.
+	depth := 0
+	for _, part := range strings.Split(filepath.ToSlash(name), "/") {
+		switch part {
+		case "", ".":
+		case "..":
+			depth--
+			if depth < 0 {
+				return "", g.denied(op, name, "path escapes the root")
+			}
+		default:
+			depth++
+		}
+	}
+	hostPath := filepath.Join(g.root, filepath.FromSlash(name))
+
+	// Check the symlinks don't escape the root, starting with the longest path that exists.
+	for p := hostPath; ; p = filepath.Dir(p) {
+		resolved, err := filepath.EvalSymlinks(p)
+		if err == nil {
+			if !pathWithin(g.resolvedRoot, resolved) {
+				return "", g.denied(op, name, "symlink escapes the root")
+			}
+			break
+		}
+
+		// Dangling symlinks could be used to create files outside the root.
+		if info, err := os.Lstat(p); err == nil && info.Mode()&fs.ModeSymlink != 0 {
+			return "", g.denied(op, name, "symlink escapes the root")
+		}
+
+		if p == g.root || p == filepath.Dir(p) {
+			break
+		}
+	}
+
+	return hostPath, nil
+}
+
+// writeHostPath is like hostPath but for write operations.
+func (g *filesystemGuard) writeHostPath(op, name string) (string, error) {
+	if g.readOnly {
+		return "", g.denied(op, name, "write denied")
+	}
+
+	return g.hostPath(op, name)
+}
+
+// pluginPath returns the plugin path of a host path.
+func (g *filesystemGuard) pluginPath(hostPath string) string {
+	if g.root == "" {
+		return hostPath
+	}
+
+	return "/" + strings.TrimPrefix(strings.TrimPrefix(hostPath, g.root), string(filepath.Separator))
+}
+
+func (g *filesystemGuard) tempDir(dir string) string {
+	if dir != "" {
+		return dir
+	}
+
+	if g.root != "" {
+		return virtualTempDir
+	}
+
+	return os.TempDir()
+}
+
+func pathWithin(root, p string) bool {
+	rel, err := filepath.Rel(root, p)
+	if err != nil {
+		return false
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// guardedFS is the `os.DirFS` of the plugins.
+type guardedFS struct {
+	guard *filesystemGuard
+	dir   string
+}
+
+func (g guardedFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	f, err := g.guard.openFile("open", path.Join(g.dir, name), os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// openFile opens a plugin file, the write flags require a write operation.
+func (g *filesystemGuard) openFile(op, name string, flag int, perm fs.FileMode) (*pluginFile, error) {
+	getPath := g.hostPath
+	if flag&openFileWriteFlags != 0 {
+		getPath = g.writeHostPath
+	}
+	p, err := getPath(op, name)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := g.openHostFile(p, flag, perm)
+	if err != nil {
+		return nil, &fs.PathError{Op: op, Path: name, Err: err}
+	}
+
+	return &pluginFile{file: f, guard: g, name: name}, nil
+}
+
+// openHostFile opens a checked host path, with a root the path is resolved and opened from the root without
+// following symlinks, so the checked path components can't be replaced with symlinks that escape the root.
+func (g *filesystemGuard) openHostFile(hostPath string, flag int, perm fs.FileMode) (*os.File, error) {
+	if g.root == "" {
+		f, err := os.OpenFile(hostPath, flag, perm)
+		if err != nil {
+			return nil, pathErrorCause(err)
+		}
+		return f, nil
+	}
+
+	rel, err := g.resolveHostPath(hostPath, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return openFileBeneath(g.resolvedRoot, rel, flag, perm)
+}
+
+// resolveHostPath resolves a checked host path from the resolved root and returns it relative to the resolved
+// root, the symlinks of the last path component are only resolved with follow.
+func (g *filesystemGuard) resolveHostPath(hostPath string, follow bool) (string, error) {
+	p, rest := hostPath, ""
+	if !follow && hostPath != g.root {
+		p, rest = filepath.Dir(hostPath), filepath.Base(hostPath)
+	}
+
+	// The paths that don't exist yet are resolved from the longest path that exists.
+	for {
+		resolved, err := filepath.EvalSymlinks(p)
+		if err == nil {
+			resolved = filepath.Join(resolved, rest)
+			if !pathWithin(g.resolvedRoot, resolved) {
+				return "", fs.ErrPermission
+			}
+			return filepath.Rel(g.resolvedRoot, resolved)
+		}
+
+		if !errors.Is(err, fs.ErrNotExist) || p == g.root || p == filepath.Dir(p) {
+			return "", pathErrorCause(err)
+		}
+		p, rest = filepath.Dir(p), filepath.Join(filepath.Base(p), rest)
+	}
+}
+
+// beneath calls an operation with a checked host path resolved from the root, the operation uses the path
+// components without following symlinks, so these can't be replaced with symlinks that escape the root after
+// checking the path.
+func (g *filesystemGuard) beneath(op, name, hostPath string, follow bool, fn func(rel string) error) error {
+	rel, err := g.resolveHostPath(hostPath, follow)
+	if err == nil {
+		err = fn(rel)
+	}
+	if err != nil {
+		return &fs.PathError{Op: op, Path: name, Err: err}
+	}
+
+	return nil
+}
+
+func pathErrorCause(err error) error {
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return pathErr.Err
+	}
+
+	var linkErr *os.LinkError
+	if errors.As(err, &linkErr) {
+		return linkErr.Err
+	}
+
+	return err
+}
+
+// readDir reads a plugin directory sorted by name.
+func (g *filesystemGuard) readDir(name string) ([]fs.DirEntry, error) {
+	f, err := g.openFile("open", name, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	entries, err := f.ReadDir(-1)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	return entries, err
+}
+
+// readFile reads a plugin file.
+func (g *filesystemGuard) readFile(name string) ([]byte, error) {
+	f, err := g.openFile("open", name, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return io.ReadAll(f)
+}
+
+// writeFile writes a plugin file.
+func (g *filesystemGuard) writeFile(name string, data []byte, perm fs.FileMode) error {
+	f, err := g.openFile("open", name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data)
+	if err1 := f.Close(); err1 != nil && err == nil {
+		err = err1
+	}
+
+	return err
+}
+
+// createTemp creates a temporary plugin file like `os.CreateTemp`, using the guarded file opening.
+func (g *filesystemGuard) createTemp(dir, pattern string) (*pluginFile, error) {
+	var f *pluginFile
+	err := g.tempPath("createtemp", dir, pattern, func(name string) (err error) {
+		f, err = g.openFile("createtemp", name, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o600)
+		return err
+	})
+
+	return f, err
+}
+
+// mkdirTemp creates a temporary plugin directory like `os.MkdirTemp`, using the guarded directory creation.
+func (g *filesystemGuard) mkdirTemp(dir, pattern string) (string, error) {
+	var dirName string
+	err := g.tempPath("mkdirtemp", dir, pattern, func(name string) error {
+		dirName = name
+		return g.mkdir("mkdirtemp", name, 0o700)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return dirName, nil
+}
+
+// tempPath calls create with random temporary paths of a pattern until one doesn't exist.
+func (g *filesystemGuard) tempPath(op, dir, pattern string, create func(name string) error) error {
+	if strings.ContainsRune(pattern, os.PathSeparator) {
+		return &fs.PathError{Op: op, Path: pattern, Err: errors.New("pattern contains path separator")}
+	}
+
+	prefix, suffix := pattern, ""
+	if i := strings.LastIndex(pattern, "*"); i >= 0 {
+		prefix, suffix = pattern[:i], pattern[i+1:]
+	}
+
+	dir = g.tempDir(dir)
+	for try := 0; ; try++ {
+		err := create(filepath.Join(dir, prefix+strconv.FormatUint(uint64(rand.Uint32()), 10)+suffix))
+		if errors.Is(err, fs.ErrExist) && try < 10000 {
+			continue
+		}
+		return err
+	}
+}
+
+func (g *filesystemGuard) mkdir(op, name string, perm fs.FileMode) error {
+	p, err := g.writeHostPath(op, name)
+	if err != nil {
+		return err
+	}
+	if g.root == "" {
+		return os.Mkdir(p, perm)
+	}
+
+	return g.beneath(op, name, p, false, func(rel string) error { return mkdirBeneath(g.resolvedRoot, rel, perm) })
+}
+
+func (g *filesystemGuard) stat(op, name string, follow bool) (fs.FileInfo, error) {
+	p, err := g.hostPath(op, name)
+	if err != nil {
+		return nil, err
+	}
+	if g.root == "" {
+		if follow {
+			return os.Stat(p)
+		}
+		return os.Lstat(p)
+	}
+
+	var info fs.FileInfo
+	err = g.beneath(op, name, p, follow, func(rel string) (err error) {
+		info, err = lstatBeneath(g.resolvedRoot, rel)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The resolved path name could be a different one when following symlinks.
+	return namedFileInfo{FileInfo: info, name: filepath.Base(p)}, nil
+}
+
+// namedFileInfo is a file info with the name of the path used to get it, like `os.Stat`.
+type namedFileInfo struct {
+	fs.FileInfo
+	name string
+}
+
+func (n namedFileInfo) Name() string { return n.name }
+
+// pluginFile is the `os.File` of the plugins with a filesystem policy, it checks the file operations that don't
+// use a path (e.g: `Chmod`) and returns the plugin path as the file name.
+type pluginFile struct {
+	file  *os.File
+	guard *filesystemGuard
+	name  string
+}
+
+func (f *pluginFile) osFile() *os.File {
+	if f == nil {
+		return nil
+	}
+
+	return f.file
+}
+
+func (f *pluginFile) Name() string {
+	if f == nil {
+		return ""
+	}
+
+	return f.name
+}
+
+func (f *pluginFile) Chmod(mode fs.FileMode) error {
+	if f != nil && f.guard.readOnly {
+		return f.guard.denied("chmod", f.name, "write denied")
+	}
+
+	return f.osFile().Chmod(mode)
+}
+
+func (f *pluginFile) Chown(uid, gid int) error {
+	if f != nil && f.guard.readOnly {
+		return f.guard.denied("chown", f.name, "write denied")
+	}
+
+	return f.osFile().Chown(uid, gid)
+}
+
+func (f *pluginFile) Truncate(size int64) error {
+	if f != nil && f.guard.readOnly {
+		return f.guard.denied("truncate", f.name, "write denied")
+	}
+
+	return f.osFile().Truncate(size)
+}
+
+func (f *pluginFile) Chdir() error {
+	if f != nil && f.guard.root != "" {
+		return f.guard.denied("chdir", f.name, "working directory change denied")
+	}
+
+	return f.osFile().Chdir()
+}
+
+func (f *pluginFile) Close() error                            { return f.osFile().Close() }
+func (f *pluginFile) Fd() uintptr                             { return f.osFile().Fd() }
+func (f *pluginFile) Read(b []byte) (int, error)              { return f.osFile().Read(b) }
+func (f *pluginFile) ReadAt(b []byte, off int64) (int, error) { return f.osFile().ReadAt(b, off) }
+func (f *pluginFile) ReadDir(n int) ([]fs.DirEntry, error)    { return f.osFile().ReadDir(n) }
+func (f *pluginFile) ReadFrom(r io.Reader) (int64, error)     { return f.osFile().ReadFrom(r) }
+func (f *pluginFile) Readdir(n int) ([]fs.FileInfo, error)    { return f.osFile().Readdir(n) }
+func (f *pluginFile) Readdirnames(n int) ([]string, error)    { return f.osFile().Readdirnames(n) }
+func (f *pluginFile) Seek(offset int64, whence int) (int64, error) {
+	return f.osFile().Seek(offset, whence)
+}
+func (f *pluginFile) SetDeadline(t time.Time) error      { return f.osFile().SetDeadline(t) }
+func (f *pluginFile) SetReadDeadline(t time.Time) error  { return f.osFile().SetReadDeadline(t) }
+func (f *pluginFile) SetWriteDeadline(t time.Time) error { return f.osFile().SetWriteDeadline(t) }
+func (f *pluginFile) Stat() (fs.FileInfo, error)         { return f.osFile().Stat() }
+func (f *pluginFile) Sync() error                        { return f.osFile().Sync() }
+func (f *pluginFile) SyscallConn() (syscall.RawConn, error) {
+	return f.osFile().SyscallConn()
+}
+func (f *pluginFile) Write(b []byte) (int, error)              { return f.osFile().Write(b) }
+func (f *pluginFile) WriteAt(b []byte, off int64) (int, error) { return f.osFile().WriteAt(b, off) }
+func (f *pluginFile) WriteString(s string) (int, error)        { return f.osFile().WriteString(s) }
+
+// stdioSymbols returns the plugin standard streams, Yaegi replaces these when the standard library is used, so
+// they are wrapped afterwards.
+func (g *filesystemGuard) stdioSymbols(symbols interp.Exports, stdio *pluginStdio) interp.Exports {
+	if _, ok := symbols["os/os"]; !ok {
+		return interp.Exports{}
+	}
+
+	stdin := &pluginFile{file: stdio.stdin, guard: g, name: "/dev/stdin"}
+	stdout := &pluginFile{file: stdio.stdout, guard: g, name: "/dev/stdout"}
+	stderr := &pluginFile{file: stdio.stderr, guard: g, name: "/dev/stderr"}
+
+	return interp.Exports{"os/os": {
+		"Stdin":  reflect.ValueOf(&stdin).Elem(),
+		"Stdout": reflect.ValueOf(&stdout).Elem(),
+		"Stderr": reflect.ValueOf(&stderr).Elem(),
+	}}
+}
+
+const openFileWriteFlags = os.O_WRONLY | os.O_RDWR | os.O_CREATE | os.O_APPEND | os.O_TRUNC
+
+// apply replaces the filesystem symbols with the ones that check the filesystem policy, the symbols are copied
+// so the original ones are not modified.
+func (g *filesystemGuard) apply(symbols interp.Exports) {
+	if osSymbols, ok := symbols["os/os"]; ok {
+		s := copySymbols(osSymbols)
+		g.applyOS(s)
+		symbols["os/os"] = s
+	}
+
+	if ioutilSymbols, ok := symbols["io/ioutil/ioutil"]; ok {
+		s := copySymbols(ioutilSymbols)
+		g.applyIOUtil(s)
+		symbols["io/ioutil/ioutil"] = s
+	}
+
+	if filepathSymbols, ok := symbols["path/filepath/filepath"]; ok {
+		s := copySymbols(filepathSymbols)
+		g.applyFilepath(s)
+		symbols["path/filepath/filepath"] = s
+	}
+
+	if parserSymbols, ok := symbols["go/parser/parser"]; ok {
+		s := copySymbols(parserSymbols)
+		s["ParseFile"] = reflect.ValueOf(func(fset *token.FileSet, filename string, src interface{}, mode parser.Mode) (*ast.File, error) {
+			if src == nil {
+				data, err := g.readFile(filename)
+				if err != nil {
+					return nil, err
+				}
+				src = data
+			}
+			return parser.ParseFile(fset, filename, src, mode)
+		})
+		symbols["go/parser/parser"] = s
+	}
+
+	for key, names := range filesystemDeniedSymbols {
+		pkgSymbols, ok := symbols[key]
+		if !ok {
+			continue
+		}
+		s := copySymbols(pkgSymbols)
+		for _, name := range names {
+			delete(s, name)
+		}
+		symbols[key] = s
+	}
+}
+
+func (g *filesystemGuard) applyOS(s map[string]reflect.Value) {
+	// Read operations.
+	s["File"] = reflect.ValueOf((*pluginFile)(nil))
+	s["Open"] = reflect.ValueOf(func(name string) (*pluginFile, error) {
+		return g.openFile("open", name, os.O_RDONLY, 0)
+	})
+	s["Stat"] = reflect.ValueOf(func(name string) (fs.FileInfo, error) {
+		return g.stat("stat", name, true)
+	})
+	s["Lstat"] = reflect.ValueOf(func(name string) (fs.FileInfo, error) {
+		return g.stat("lstat", name, false)
+	})
+	s["ReadFile"] = reflect.ValueOf(g.readFile)
+	s["ReadDir"] = reflect.ValueOf(g.readDir)
+	s["Readlink"] = reflect.ValueOf(func(name string) (string, error) {
+		p, err := g.hostPath("readlink", name)
+		if err != nil {
+			return "", err
+		}
+		if g.root == "" {
+			return os.Readlink(p)
+		}
+		var target string
+		err = g.beneath("readlink", name, p, false, func(rel string) (err error) {
+			target, err = readlinkBeneath(g.resolvedRoot, rel)
+			return err
+		})
+		return target, err
+	})
+	s["DirFS"] = reflect.ValueOf(func(dir string) fs.FS {
+		return guardedFS{guard: g, dir: dir}
+	})
+
+	// Write operations.
+	s["Create"] = reflect.ValueOf(func(name string) (*pluginFile, error) {
+		return g.openFile("open", name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+	})
+	s["OpenFile"] = reflect.ValueOf(func(name string, flag int, perm fs.FileMode) (*pluginFile, error) {
+		return g.openFile("open", name, flag, perm)
+	})
+	s["WriteFile"] = reflect.ValueOf(g.writeFile)
+	s["Mkdir"] = reflect.ValueOf(func(name string, perm fs.FileMode) error {
+		return g.mkdir("mkdir", name, perm)
+	})
+	s["MkdirAll"] = reflect.ValueOf(func(name string, perm fs.FileMode) error {
+		p, err := g.writeHostPath("mkdir", name)
+		if err != nil {
+			return err
+		}
+		if g.root == "" {
+			return os.MkdirAll(p, perm)
+		}
+		return g.beneath("mkdir", name, p, true, func(rel string) error { return mkdirAllBeneath(g.resolvedRoot, rel, perm) })
+	})
+	s["MkdirTemp"] = reflect.ValueOf(g.mkdirTemp)
+	s["CreateTemp"] = reflect.ValueOf(g.createTemp)
+	s["Remove"] = reflect.ValueOf(func(name string) error {
+		p, err := g.writeHostPath("remove", name)
+		if err != nil {
+			return err
+		}
+		if g.root == "" {
+			return os.Remove(p)
+		}
+		return g.beneath("remove", name, p, false, func(rel string) error { return removeBeneath(g.resolvedRoot, rel) })
+	})
+	s["RemoveAll"] = reflect.ValueOf(func(name string) error {
+		p, err := g.writeHostPath("removeall", name)
+		if err != nil {
+			return err
+		}
+		if g.root == "" {
+			return os.RemoveAll(p)
+		}
+		return g.beneath("removeall", name, p, false, func(rel string) error { return removeAllBeneath(g.resolvedRoot, rel) })
+	})
+	s["Rename"] = reflect.ValueOf(func(oldName, newName string) error {
+		oldPath, err := g.writeHostPath("rename", oldName)
+		if err != nil {
+			return err
+		}
+		newPath, err := g.writeHostPath("rename", newName)
+		if err != nil {
+			return err
+		}
+		if g.root == "" {
+			return os.Rename(oldPath, newPath)
+		}
+		oldRel, err := g.resolveHostPath(oldPath, false)
+		if err != nil {
+			return &os.LinkError{Op: "rename", Old: oldName, New: newName, Err: err}
+		}
+		newRel, err := g.resolveHostPath(newPath, false)
+		if err == nil {
+			err = renameBeneath(g.resolvedRoot, oldRel, newRel)
+		}
+		if err != nil {
+			return &os.LinkError{Op: "rename", Old: oldName, New: newName, Err: err}
+		}
+		return nil
+	})
+	s["Chmod"] = reflect.ValueOf(func(name string, mode fs.FileMode) error {
+		p, err := g.writeHostPath("chmod", name)
+		if err != nil {
+			return err
+		}
+		if g.root == "" {
+			return os.Chmod(p, mode)
+		}
+		return g.beneath("chmod", name, p, true, func(rel string) error { return chmodBeneath(g.resolvedRoot, rel, mode) })
+	})
+	s["Chown"] = reflect.ValueOf(func(name string, uid, gid int) error {
+		p, err := g.writeHostPath("chown", name)
+		if err != nil {
+			return err
+		}
+		if g.root == "" {
+			return os.Chown(p, uid, gid)
+		}
+		return g.beneath("chown", name, p, true, func(rel string) error { return chownBeneath(g.resolvedRoot, rel, uid, gid) })
+	})
+	s["Lchown"] = reflect.ValueOf(func(name string, uid, gid int) error {
+		p, err := g.writeHostPath("lchown", name)
+		if err != nil {
+			return err
+		}
+		if g.root == "" {
+			return os.Lchown(p, uid, gid)
+		}
+		return g.beneath("lchown", name, p, false, func(rel string) error { return chownBeneath(g.resolvedRoot, rel, uid, gid) })
+	})
+	s["Chtimes"] = reflect.ValueOf(func(name string, atime, mtime time.Time) error {
+		p, err := g.writeHostPath("chtimes", name)
+		if err != nil {
+			return err
+		}
+		if g.root == "" {
+			return os.Chtimes(p, atime, mtime)
+		}
+		return g.beneath("chtimes", name, p, true, func(rel string) error { return chtimesBeneath(g.resolvedRoot, rel, atime, mtime) })
+	})
+	s["Truncate"] = reflect.ValueOf(func(name string, size int64) error {
+		p, err := g.writeHostPath("truncate", name)
+		if err != nil {
+			return err
+		}
+		if g.root == "" {
+			return os.Truncate(p, size)
+		}
+		return g.beneath("truncate", name, p, true, func(rel string) error { return truncateBeneath(g.resolvedRoot, rel, size) })
+	})
+	s["Symlink"] = reflect.ValueOf(func(oldName, newName string) error {
+		if g.root != "" {
+			return &os.LinkError{Op: "symlink", Old: oldName, New: newName, Err: g.denied("symlink", newName, "links denied")}
+		}
+		p, err := g.writeHostPath("symlink", newName)
+		if err != nil {
+			return err
+		}
+		return os.Symlink(oldName, p)
+	})
+	s["Link"] = reflect.ValueOf(func(oldName, newName string) error {
+		if g.root != "" {
+			return &os.LinkError{Op: "link", Old: oldName, New: newName, Err: g.denied("link", newName, "links denied")}
+		}
+		p, err := g.writeHostPath("link", newName)
+		if err != nil {
+			return err
+		}
+		return os.Link(oldName, p)
+	})
+
+	s["NewFile"] = reflect.ValueOf(func(fd uintptr, name string) *pluginFile {
+		f := os.NewFile(fd, name)
+		if f == nil {
+			return nil
+		}
+		return &pluginFile{file: f, guard: g, name: name}
+	})
+	s["Pipe"] = reflect.ValueOf(func() (*pluginFile, *pluginFile, error) {
+		r, w, err := os.Pipe()
+		if err != nil {
+			return nil, nil, err
+		}
+		return &pluginFile{file: r, guard: g, name: r.Name()}, &pluginFile{file: w, guard: g, name: w.Name()}, nil
+	})
+
+	// Working directory and processes, the started processes would not have the filesystem policy.
+	if g.root != "" {
+		s["Getwd"] = reflect.ValueOf(func() (string, error) { return "/", nil })
+		s["Chdir"] = reflect.ValueOf(func(dir string) error { return g.denied("chdir", dir, "working directory change denied") })
+		s["TempDir"] = reflect.ValueOf(func() string { return virtualTempDir })
+	}
+	delete(s, "StartProcess")
+}
+
+func (g *filesystemGuard) applyIOUtil(s map[string]reflect.Value) {
+	s["ReadFile"] = reflect.ValueOf(g.readFile)
+	s["ReadDir"] = reflect.ValueOf(func(name string) ([]fs.FileInfo, error) {
+		f, err := g.openFile("open", name, os.O_RDONLY, 0)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		infos, err := f.Readdir(-1)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(infos, func(i, j int) bool { return infos[i].Name() < infos[j].Name() })
+		return infos, nil
+	})
+	s["WriteFile"] = reflect.ValueOf(g.writeFile)
+	s["TempDir"] = reflect.ValueOf(g.mkdirTemp)
+	s["TempFile"] = reflect.ValueOf(g.createTemp)
+}
+
+func (g *filesystemGuard) applyFilepath(s map[string]reflect.Value) {
+	s["Walk"] = reflect.ValueOf(func(root string, fn filepath.WalkFunc) error {
+		p, err := g.hostPath("lstat", root)
+		if err != nil {
+			return fn(root, nil, err)
+		}
+		return filepath.Walk(p, func(p string, info fs.FileInfo, err error) error {
+			return fn(g.pluginWalkPath(root, p), info, err)
+		})
+	})
+	s["WalkDir"] = reflect.ValueOf(func(root string, fn fs.WalkDirFunc) error {
+		p, err := g.hostPath("lstat", root)
+		if err != nil {
+			return fn(root, nil, err)
+		}
+		return filepath.WalkDir(p, func(p string, d fs.DirEntry, err error) error {
+			return fn(g.pluginWalkPath(root, p), d, err)
+		})
+	})
+	s["Glob"] = reflect.ValueOf(func(pattern string) ([]string, error) {
+		if g.root == "" {
+			return filepath.Glob(pattern)
+		}
+		if _, err := g.hostPath("glob", pattern); err != nil {
+			return nil, err
+		}
+		matches, err := filepath.Glob(filepath.Join(escapeGlob(g.root), filepath.FromSlash(pattern)))
+		if err != nil {
+			return nil, err
+		}
+		for i, m := range matches {
+			matches[i] = g.pluginPath(m)
+		}
+		return matches, nil
+	})
+	s["EvalSymlinks"] = reflect.ValueOf(func(name string) (string, error) {
+		p, err := g.hostPath("lstat", name)
+		if err != nil {
+			return "", err
+		}
+		resolved, err := filepath.EvalSymlinks(p)
+		if err != nil || g.root == "" {
+			return resolved, err
+		}
+		return g.pluginPath(filepath.Join(g.root, strings.TrimPrefix(resolved, g.resolvedRoot))), nil
+	})
+	if g.root != "" {
+		s["Abs"] = reflect.ValueOf(func(name string) (string, error) {
+			if _, err := g.hostPath("abs", name); err != nil {
+				return "", err
+			}
+			return filepath.Join("/", name), nil
+		})
+	}
+}
+
+// pluginWalkPath returns the plugin path of a walked host path, keeping the walk root as the user set it.
+func (g *filesystemGuard) pluginWalkPath(root, hostPath string) string {
+	if g.root == "" {
+		return hostPath
+	}
+
+	hostRoot := filepath.Join(g.root, filepath.FromSlash(root))
+	return filepath.Join(root, strings.TrimPrefix(hostPath, hostRoot))
+}
+
+func escapeGlob(p string) string {
+	r := strings.NewReplacer(`*`, `\*`, `?`, `\?`, `[`, `\[`, `\`, `\\`)
+	return r.Replace(p)
+}
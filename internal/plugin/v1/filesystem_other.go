@@ -0,0 +1,68 @@
+//go:build !linux && !darwin && !freebsd
+
+package v1
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// The platform doesn't support operating on the path components without following symlinks, the resolved paths
+// relative to the root directory are used with the `os` package.
+
+func openFileBeneath(root, rel string, flag int, perm fs.FileMode) (*os.File, error) {
+	f, err := os.OpenFile(filepath.Join(root, rel), flag, perm)
+	if err != nil {
+		return nil, pathErrorCause(err)
+	}
+
+	return f, nil
+}
+
+func lstatBeneath(root, rel string) (fs.FileInfo, error) {
+	info, err := os.Lstat(filepath.Join(root, rel))
+	return info, pathErrorCause(err)
+}
+
+func readlinkBeneath(root, rel string) (string, error) {
+	target, err := os.Readlink(filepath.Join(root, rel))
+	return target, pathErrorCause(err)
+}
+
+func mkdirBeneath(root, rel string, perm fs.FileMode) error {
+	return pathErrorCause(os.Mkdir(filepath.Join(root, rel), perm))
+}
+
+func mkdirAllBeneath(root, rel string, perm fs.FileMode) error {
+	return pathErrorCause(os.MkdirAll(filepath.Join(root, rel), perm))
+}
+
+func removeBeneath(root, rel string) error {
+	return pathErrorCause(os.Remove(filepath.Join(root, rel)))
+}
+
+func removeAllBeneath(root, rel string) error {
+	return pathErrorCause(os.RemoveAll(filepath.Join(root, rel)))
+}
+
+func renameBeneath(root, oldRel, newRel string) error {
+	return pathErrorCause(os.Rename(filepath.Join(root, oldRel), filepath.Join(root, newRel)))
+}
+
+func chmodBeneath(root, rel string, mode fs.FileMode) error {
+	return pathErrorCause(os.Chmod(filepath.Join(root, rel), mode))
+}
+
+func chownBeneath(root, rel string, uid, gid int) error {
+	return pathErrorCause(os.Lchown(filepath.Join(root, rel), uid, gid))
+}
+
+func chtimesBeneath(root, rel string, atime, mtime time.Time) error {
+	return pathErrorCause(os.Chtimes(filepath.Join(root, rel), atime, mtime))
+}
+
+func truncateBeneath(root, rel string, size int64) error {
+	return pathErrorCause(os.Truncate(filepath.Join(root, rel), size))
+}
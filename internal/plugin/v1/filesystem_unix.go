@@ -0,0 +1,301 @@
+//go:build linux || darwin || freebsd
+
+package v1
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// openDirBeneath opens a resolved directory relative to the root directory one component at a time without
+// following symlinks, so it fails if a component has been replaced with a symlink after resolving the path.
+func openDirBeneath(root, rel string) (int, error) {
+	dirFD, err := unix.Open(root, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return -1, err
+	}
+
+	if rel == "." {
+		return dirFD, nil
+	}
+
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		fd, err := unix.Openat(dirFD, part, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+		_ = unix.Close(dirFD)
+		if err != nil {
+			return -1, err
+		}
+		dirFD = fd
+	}
+
+	return dirFD, nil
+}
+
+// atBeneath calls an operation with the directory of a resolved path opened using `openDirBeneath` and the last
+// path component, the operations must not follow the last path component if it's a symlink.
+func atBeneath(root, rel string, op func(dirFD int, base string) error) error {
+	dirFD, err := openDirBeneath(root, filepath.Dir(rel))
+	if err != nil {
+		return err
+	}
+	defer unix.Close(dirFD)
+
+	return op(dirFD, filepath.Base(rel))
+}
+
+// openFileBeneath opens a resolved path relative to the root directory one component at a time without following
+// symlinks, so it fails if a component has been replaced with a symlink after resolving the path.
+func openFileBeneath(root, rel string, flag int, perm fs.FileMode) (*os.File, error) {
+	var f *os.File
+	err := atBeneath(root, rel, func(dirFD int, base string) error {
+		fd, err := unix.Openat(dirFD, base, flag|unix.O_NOFOLLOW|unix.O_CLOEXEC, unixMode(perm))
+		if err != nil {
+			return err
+		}
+		f = os.NewFile(uintptr(fd), filepath.Join(root, rel))
+		return nil
+	})
+
+	return f, err
+}
+
+func lstatBeneath(root, rel string) (fs.FileInfo, error) {
+	info := &statFileInfo{name: filepath.Base(rel)}
+	err := atBeneath(root, rel, func(dirFD int, base string) error {
+		return unix.Fstatat(dirFD, base, &info.stat, unix.AT_SYMLINK_NOFOLLOW)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func readlinkBeneath(root, rel string) (string, error) {
+	var target string
+	err := atBeneath(root, rel, func(dirFD int, base string) error {
+		for size := 128; ; size *= 2 {
+			buf := make([]byte, size)
+			n, err := unix.Readlinkat(dirFD, base, buf)
+			if err != nil {
+				return err
+			}
+			if n < size {
+				target = string(buf[:n])
+				return nil
+			}
+		}
+	})
+
+	return target, err
+}
+
+func mkdirBeneath(root, rel string, perm fs.FileMode) error {
+	return atBeneath(root, rel, func(dirFD int, base string) error {
+		return unix.Mkdirat(dirFD, base, unixMode(perm))
+	})
+}
+
+// mkdirAllBeneath is like `os.MkdirAll` creating and opening the directories one component at a time without
+// following symlinks.
+func mkdirAllBeneath(root, rel string, perm fs.FileMode) error {
+	dirFD, err := openDirBeneath(root, ".")
+	if err != nil {
+		return err
+	}
+
+	if rel != "." {
+		for _, part := range strings.Split(rel, string(filepath.Separator)) {
+			err := unix.Mkdirat(dirFD, part, unixMode(perm))
+			if err != nil && err != unix.EEXIST {
+				_ = unix.Close(dirFD)
+				return err
+			}
+
+			fd, err := unix.Openat(dirFD, part, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+			_ = unix.Close(dirFD)
+			if err != nil {
+				return err
+			}
+			dirFD = fd
+		}
+	}
+
+	return unix.Close(dirFD)
+}
+
+// removeBeneath is like `os.Remove`, removing a file or an empty directory.
+func removeBeneath(root, rel string) error {
+	return atBeneath(root, rel, func(dirFD int, base string) error {
+		err := unix.Unlinkat(dirFD, base, 0)
+		if err == nil {
+			return nil
+		}
+
+		err1 := unix.Unlinkat(dirFD, base, unix.AT_REMOVEDIR)
+		if err1 == nil {
+			return nil
+		}
+		if err1 != unix.ENOTDIR {
+			err = err1
+		}
+		return err
+	})
+}
+
+// removeAllBeneath is like `os.RemoveAll`, opening the directories without following symlinks.
+func removeAllBeneath(root, rel string) error {
+	if filepath.Base(rel) == "." {
+		return unix.EINVAL
+	}
+
+	return atBeneath(root, rel, removeAllAt)
+}
+
+func removeAllAt(dirFD int, base string) error {
+	err := unix.Unlinkat(dirFD, base, 0)
+	if err == nil || err == unix.ENOENT {
+		return nil
+	}
+
+	// Not a directory, or a directory that needs to be emptied first.
+	fd, err1 := unix.Openat(dirFD, base, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+	if err1 == unix.ENOENT {
+		return nil
+	}
+	if err1 != nil {
+		return err
+	}
+
+	dir := os.NewFile(uintptr(fd), base)
+	names, err := dir.Readdirnames(-1)
+	for _, name := range names {
+		if err1 := removeAllAt(fd, name); err1 != nil && err == nil {
+			err = err1
+		}
+	}
+	_ = dir.Close()
+
+	err1 = unix.Unlinkat(dirFD, base, unix.AT_REMOVEDIR)
+	if err1 == nil || err1 == unix.ENOENT {
+		return nil
+	}
+	if err == nil {
+		err = err1
+	}
+
+	return err
+}
+
+func renameBeneath(root, oldRel, newRel string) error {
+	return atBeneath(root, oldRel, func(oldDirFD int, oldBase string) error {
+		return atBeneath(root, newRel, func(newDirFD int, newBase string) error {
+			return unix.Renameat(oldDirFD, oldBase, newDirFD, newBase)
+		})
+	})
+}
+
+// chmodBeneath changes the mode of an opened file, Linux doesn't support changing the mode of a path without
+// following symlinks.
+func chmodBeneath(root, rel string, mode fs.FileMode) error {
+	return atBeneath(root, rel, func(dirFD int, base string) error {
+		fd, err := unix.Openat(dirFD, base, unix.O_RDONLY|unix.O_NOFOLLOW|unix.O_NONBLOCK|unix.O_CLOEXEC, 0)
+		if err == unix.EACCES {
+			fd, err = unix.Openat(dirFD, base, unix.O_WRONLY|unix.O_NOFOLLOW|unix.O_NONBLOCK|unix.O_CLOEXEC, 0)
+		}
+		if err != nil {
+			return err
+		}
+		defer unix.Close(fd)
+
+		return unix.Fchmod(fd, unixMode(mode))
+	})
+}
+
+func chownBeneath(root, rel string, uid, gid int) error {
+	return atBeneath(root, rel, func(dirFD int, base string) error {
+		return unix.Fchownat(dirFD, base, uid, gid, unix.AT_SYMLINK_NOFOLLOW)
+	})
+}
+
+func chtimesBeneath(root, rel string, atime, mtime time.Time) error {
+	return atBeneath(root, rel, func(dirFD int, base string) error {
+		ts := []unix.Timespec{unix.NsecToTimespec(atime.UnixNano()), unix.NsecToTimespec(mtime.UnixNano())}
+		return unix.UtimesNanoAt(dirFD, base, ts, unix.AT_SYMLINK_NOFOLLOW)
+	})
+}
+
+func truncateBeneath(root, rel string, size int64) error {
+	return atBeneath(root, rel, func(dirFD int, base string) error {
+		fd, err := unix.Openat(dirFD, base, unix.O_WRONLY|unix.O_NOFOLLOW|unix.O_NONBLOCK|unix.O_CLOEXEC, 0)
+		if err != nil {
+			return err
+		}
+		defer unix.Close(fd)
+
+		return unix.Ftruncate(fd, size)
+	})
+}
+
+// unixMode returns the unix mode bits of a file mode like the `os` package.
+func unixMode(mode fs.FileMode) uint32 {
+	m := uint32(mode.Perm())
+	if mode&fs.ModeSetuid != 0 {
+		m |= unix.S_ISUID
+	}
+	if mode&fs.ModeSetgid != 0 {
+		m |= unix.S_ISGID
+	}
+	if mode&fs.ModeSticky != 0 {
+		m |= unix.S_ISVTX
+	}
+
+	return m
+}
+
+// statFileInfo is the `fs.FileInfo` of a file stat.
+type statFileInfo struct {
+	name string
+	stat unix.Stat_t
+}
+
+func (s *statFileInfo) Name() string       { return s.name }
+func (s *statFileInfo) Size() int64        { return s.stat.Size }
+func (s *statFileInfo) IsDir() bool        { return s.Mode().IsDir() }
+func (s *statFileInfo) Sys() interface{}   { return &s.stat }
+func (s *statFileInfo) ModTime() time.Time { return time.Unix(s.stat.Mtim.Unix()) }
+
+func (s *statFileInfo) Mode() fs.FileMode {
+	sysMode := uint32(s.stat.Mode)
+	mode := fs.FileMode(sysMode & 0o777)
+	switch sysMode & unix.S_IFMT {
+	case unix.S_IFBLK:
+		mode |= fs.ModeDevice
+	case unix.S_IFCHR:
+		mode |= fs.ModeDevice | fs.ModeCharDevice
+	case unix.S_IFDIR:
+		mode |= fs.ModeDir
+	case unix.S_IFIFO:
+		mode |= fs.ModeNamedPipe
+	case unix.S_IFLNK:
+		mode |= fs.ModeSymlink
+	case unix.S_IFSOCK:
+		mode |= fs.ModeSocket
+	}
+	if sysMode&unix.S_ISUID != 0 {
+		mode |= fs.ModeSetuid
+	}
+	if sysMode&unix.S_ISGID != 0 {
+		mode |= fs.ModeSetgid
+	}
+	if sysMode&unix.S_ISVTX != 0 {
+		mode |= fs.ModeSticky
+	}
+
+	return mode
+}
@@ -0,0 +1,483 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+// NetworkPolicy restricts the network destinations that a plugin can connect to.
+//
+// The policy is enforced on the `net` dial and lookup functions and the `net/http` default transport and clients,
+// the `net.Dialer`, `net.Resolver` and `http.Transport` types and the `net` packet listeners are not available to
+// the plugin (these would bypass the policy), and the host proxy environment is ignored. The lookup functions only
+// resolve the allowed hostnames. Other standard library packages that connect on their own (e.g: `crypto/tls`,
+// `net/smtp`, `net/http/httputil`) should be denied with the sandbox policy.
+//
+// The `http.Client` of the plugin uses the plugin policy when it doesn't have a transport and the request has
+// been created by the plugin (e.g: `http.NewRequestWithContext`), other requests (e.g: `Client.Get`) must be
+// allowed by the policies of all the plugins loaded in the process.
+type NetworkPolicy struct {
+	// Name identifies the plugin on the denied connection errors.
+	Name string
+	// AllowedHosts are the hosts (e.g: `api.github.com`, `*.github.com`), IPs and CIDRs (e.g: `10.0.0.0/8`), with an
+	// optional port (e.g: `api.github.com:443`, `[::1]:8080`), that the plugin can connect to.
+	// If nil, the plugin can connect to any destination.
+	AllowedHosts []string
+}
+
+func (n NetworkPolicy) enabled() bool {
+	return n.AllowedHosts != nil
+}
+
+func (n NetworkPolicy) index() string {
+	if !n.enabled() {
+		return ""
+	}
+
+	return fmt.Sprintf("%q%q", n.Name, n.AllowedHosts)
+}
+
+// networkRule is an allowed network destination, only one of host or network is set.
+type networkRule struct {
+	host    string
+	network *net.IPNet
+	port    string
+}
+
+func newNetworkRule(allowedHost string) (networkRule, error) {
+	host, port, err := net.SplitHostPort(allowedHost)
+	if err != nil {
+		host, port = allowedHost, ""
+	}
+
+	if host == "" {
+		return networkRule{}, fmt.Errorf("host is required")
+	}
+
+	if strings.Contains(host, "/") {
+		_, network, err := net.ParseCIDR(host)
+		if err != nil {
+			return networkRule{}, fmt.Errorf("invalid CIDR: %w", err)
+		}
+		return networkRule{network: network, port: port}, nil
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		bits := 8 * net.IPv4len
+		if ip.To4() == nil {
+			bits = 8 * net.IPv6len
+		}
+		return networkRule{network: &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, port: port}, nil
+	}
+
+	return networkRule{host: strings.ToLower(host), port: port}, nil
+}
+
+func (r networkRule) matchesHost(host, port string) bool {
+	if r.host == "" || (r.port != "" && r.port != port) {
+		return false
+	}
+
+	host = strings.ToLower(host)
+	if domain := strings.TrimPrefix(r.host, "*."); domain != r.host {
+		return strings.HasSuffix(host, "."+domain)
+	}
+
+	return r.host == host
+}
+
+func (r networkRule) matchesIP(ip net.IP, port string) bool {
+	if r.network == nil || (r.port != "" && r.port != port) {
+		return false
+	}
+
+	return r.network.Contains(ip)
+}
+
+// networkGuard checks the plugin connections against the network policy rules.
+type networkGuard struct {
+	name      string
+	rules     []networkRule
+	transport *http.Transport
+}
+
+func newNetworkGuard(policy NetworkPolicy) (*networkGuard, error) {
+	rules := make([]networkRule, 0, len(policy.AllowedHosts))
+	for _, allowedHost := range policy.AllowedHosts {
+		rule, err := newNetworkRule(allowedHost)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %q allowed host: %w", allowedHost, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	g := &networkGuard{name: policy.Name, rules: rules}
+	g.transport = newGuardedTransport(g.DialContext)
+
+	return g, nil
+}
+
+// newGuardedTransport returns an HTTP transport that connects using the guarded dial function.
+func newGuardedTransport(dial func(ctx context.Context, network, address string) (net.Conn, error)) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dial
+
+	return transport
+}
+
+func (g *networkGuard) denied(network, address string) error {
+	return fmt.Errorf("plugin %q is not allowed to connect to %q (%s) by the network policy", g.name, address, network)
+}
+
+// allowedAddress returns the address that should be dialed if the destination is allowed. Hostnames that are not
+// allowed explicitly are resolved, and all the resolved IPs must be allowed, the returned address will use the
+// checked IP so the connection can't go to a different destination.
+func (g *networkGuard) allowedAddress(ctx context.Context, network, address string) (string, error) {
+	if !strings.HasPrefix(network, "tcp") && !strings.HasPrefix(network, "udp") && !strings.HasPrefix(network, "ip") {
+		return "", g.denied(network, address)
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		// IP networks don't have ports.
+		host, port = address, ""
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		if !g.ipAllowed(ip, port) {
+			return "", g.denied(network, address)
+		}
+		return address, nil
+	}
+
+	for _, rule := range g.rules {
+		if rule.matchesHost(host, port) {
+			return address, nil
+		}
+	}
+
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil || len(ips) == 0 {
+		return "", g.denied(network, address)
+	}
+	for _, ip := range ips {
+		if !g.ipAllowed(ip.IP, port) {
+			return "", g.denied(network, address)
+		}
+	}
+
+	if port == "" {
+		return ips[0].IP.String(), nil
+	}
+
+	return net.JoinHostPort(ips[0].IP.String(), port), nil
+}
+
+func (g *networkGuard) ipAllowed(ip net.IP, port string) bool {
+	for _, rule := range g.rules {
+		if rule.matchesIP(ip, port) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *networkGuard) deniedLookup(host string) error {
+	return fmt.Errorf("plugin %q is not allowed to resolve %q by the network policy", g.name, host)
+}
+
+// lookupAllowed returns if the plugin can resolve a host, the host must be allowed on any port.
+func (g *networkGuard) lookupAllowed(host string) bool {
+	host = strings.TrimSuffix(host, ".")
+	if ip := net.ParseIP(host); ip != nil {
+		for _, rule := range g.rules {
+			if rule.network != nil && rule.network.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, rule := range g.rules {
+		if rule.host != "" && (networkRule{host: rule.host}).matchesHost(host, "") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *networkGuard) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	address, err := g.allowedAddress(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	var d net.Dialer
+	return d.DialContext(ctx, network, address)
+}
+
+// apply replaces the `net` and `net/http` symbols that connect to the network with the ones that check the
+// network policy, the symbols are copied so the original ones are not modified.
+func (g *networkGuard) apply(symbols interp.Exports) {
+	if netSymbols, ok := symbols["net/net"]; ok {
+		s := copySymbols(netSymbols)
+		s["Dial"] = reflect.ValueOf(func(network, address string) (net.Conn, error) {
+			return g.DialContext(context.Background(), network, address)
+		})
+		s["DialTimeout"] = reflect.ValueOf(func(network, address string, timeout time.Duration) (net.Conn, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			return g.DialContext(ctx, network, address)
+		})
+		s["DialTCP"] = reflect.ValueOf(func(network string, laddr, raddr *net.TCPAddr) (*net.TCPConn, error) {
+			if raddr == nil || !g.ipAllowed(raddr.IP, fmt.Sprint(raddr.Port)) {
+				return nil, g.denied(network, fmt.Sprint(raddr))
+			}
+			return net.DialTCP(network, laddr, raddr)
+		})
+		s["DialUDP"] = reflect.ValueOf(func(network string, laddr, raddr *net.UDPAddr) (*net.UDPConn, error) {
+			if raddr == nil || !g.ipAllowed(raddr.IP, fmt.Sprint(raddr.Port)) {
+				return nil, g.denied(network, fmt.Sprint(raddr))
+			}
+			return net.DialUDP(network, laddr, raddr)
+		})
+		s["DialIP"] = reflect.ValueOf(func(network string, laddr, raddr *net.IPAddr) (*net.IPConn, error) {
+			if raddr == nil || !g.ipAllowed(raddr.IP, "") {
+				return nil, g.denied(network, fmt.Sprint(raddr))
+			}
+			return net.DialIP(network, laddr, raddr)
+		})
+		s["LookupHost"] = reflect.ValueOf(func(host string) ([]string, error) {
+			if !g.lookupAllowed(host) {
+				return nil, g.deniedLookup(host)
+			}
+			return net.LookupHost(host)
+		})
+		s["LookupIP"] = reflect.ValueOf(func(host string) ([]net.IP, error) {
+			if !g.lookupAllowed(host) {
+				return nil, g.deniedLookup(host)
+			}
+			return net.LookupIP(host)
+		})
+		s["LookupAddr"] = reflect.ValueOf(func(addr string) ([]string, error) {
+			if ip := net.ParseIP(addr); ip == nil || !g.lookupAllowed(addr) {
+				return nil, g.deniedLookup(addr)
+			}
+			return net.LookupAddr(addr)
+		})
+		s["LookupCNAME"] = reflect.ValueOf(func(host string) (string, error) {
+			if !g.lookupAllowed(host) {
+				return "", g.deniedLookup(host)
+			}
+			return net.LookupCNAME(host)
+		})
+		s["LookupMX"] = reflect.ValueOf(func(name string) ([]*net.MX, error) {
+			if !g.lookupAllowed(name) {
+				return nil, g.deniedLookup(name)
+			}
+			return net.LookupMX(name)
+		})
+		s["LookupNS"] = reflect.ValueOf(func(name string) ([]*net.NS, error) {
+			if !g.lookupAllowed(name) {
+				return nil, g.deniedLookup(name)
+			}
+			return net.LookupNS(name)
+		})
+		s["LookupSRV"] = reflect.ValueOf(func(service, proto, name string) (string, []*net.SRV, error) {
+			if !g.lookupAllowed(name) {
+				return "", nil, g.deniedLookup(name)
+			}
+			return net.LookupSRV(service, proto, name)
+		})
+		s["LookupTXT"] = reflect.ValueOf(func(name string) ([]string, error) {
+			if !g.lookupAllowed(name) {
+				return nil, g.deniedLookup(name)
+			}
+			return net.LookupTXT(name)
+		})
+		// The packet connections can write to any address, and the resolvers can use their own dial function.
+		for _, name := range []string{"DialUnix", "Dialer", "ListenPacket", "ListenUDP", "ListenMulticastUDP", "ListenIP", "ListenUnixgram", "Resolver", "DefaultResolver"} {
+			delete(s, name)
+		}
+		symbols["net/net"] = s
+	}
+
+	if httpSymbols, ok := symbols["net/http/http"]; ok {
+		var defaultTransport http.RoundTripper = &pluginRoundTripper{transport: g.transport}
+		defaultClient := &pluginHTTPClient{Transport: defaultTransport}
+
+		s := copySymbols(httpSymbols)
+		s["DefaultTransport"] = reflect.ValueOf(&defaultTransport).Elem()
+		s["DefaultClient"] = reflect.ValueOf(&defaultClient).Elem()
+		s["Client"] = reflect.ValueOf((*pluginHTTPClient)(nil))
+		s["Get"] = reflect.ValueOf(defaultClient.Get)
+		s["Head"] = reflect.ValueOf(defaultClient.Head)
+		s["Post"] = reflect.ValueOf(defaultClient.Post)
+		s["PostForm"] = reflect.ValueOf(defaultClient.PostForm)
+		s["NewRequest"] = reflect.ValueOf(func(method, url string, body io.Reader) (*http.Request, error) {
+			return http.NewRequestWithContext(g.context(context.Background()), method, url, body)
+		})
+		s["NewRequestWithContext"] = reflect.ValueOf(func(ctx context.Context, method, url string, body io.Reader) (*http.Request, error) {
+			return http.NewRequestWithContext(g.context(ctx), method, url, body)
+		})
+		delete(s, "Transport")
+		symbols["net/http/http"] = s
+	}
+}
+
+// pluginRoundTripper is the `http.DefaultTransport` of the plugins with a network policy, the guarded transport
+// is unexported so the plugins can't change it (e.g: using `reflect` to replace its dial function).
+type pluginRoundTripper struct {
+	transport *http.Transport
+}
+
+func (t *pluginRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.transport.RoundTrip(req)
+}
+
+func (t *pluginRoundTripper) CloseIdleConnections() { t.transport.CloseIdleConnections() }
+
+type networkGuardContextKey struct{}
+
+// context returns the context of the plugin requests, so the requests without transport use the plugin guard.
+func (g *networkGuard) context(ctx context.Context) context.Context {
+	if ctx == nil {
+		return nil
+	}
+
+	return context.WithValue(ctx, networkGuardContextKey{}, g)
+}
+
+// pluginHTTPClient is the `http.Client` of the plugins with a network policy, the `http.Client` without
+// transport would use the host `http.DefaultTransport`.
+type pluginHTTPClient struct {
+	Transport     http.RoundTripper
+	CheckRedirect func(req *http.Request, via []*http.Request) error
+	Jar           http.CookieJar
+	Timeout       time.Duration
+}
+
+func (c *pluginHTTPClient) client() *http.Client {
+	transport := c.Transport
+	if transport == nil {
+		transport = pluginGuardedTransport{}
+	}
+
+	return &http.Client{Transport: transport, CheckRedirect: c.CheckRedirect, Jar: c.Jar, Timeout: c.Timeout}
+}
+
+func (c *pluginHTTPClient) Do(req *http.Request) (*http.Response, error) { return c.client().Do(req) }
+
+func (c *pluginHTTPClient) Get(url string) (*http.Response, error) { return c.client().Get(url) }
+
+func (c *pluginHTTPClient) Head(url string) (*http.Response, error) { return c.client().Head(url) }
+
+func (c *pluginHTTPClient) Post(url, contentType string, body io.Reader) (*http.Response, error) {
+	return c.client().Post(url, contentType, body)
+}
+
+func (c *pluginHTTPClient) PostForm(url string, data url.Values) (*http.Response, error) {
+	return c.client().PostForm(url, data)
+}
+
+func (c *pluginHTTPClient) CloseIdleConnections() {
+	if c.Transport != nil {
+		c.client().CloseIdleConnections()
+	}
+}
+
+// pluginGuardedTransport is the transport of the plugin clients without transport, it uses the guard of the
+// plugin that created the request, or the guards of all the plugins if unknown.
+type pluginGuardedTransport struct{}
+
+func (pluginGuardedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if g, ok := req.Context().Value(networkGuardContextKey{}).(*networkGuard); ok {
+		return g.transport.RoundTrip(req)
+	}
+
+	return activeNetworkGuards.roundTrip(req)
+}
+
+// activeNetworkGuards are the guards of the loaded plugins.
+var activeNetworkGuards = &networkGuards{guards: map[*networkGuard]struct{}{}}
+
+// networkGuards checks the connections against the network policies of all the loaded plugins.
+type networkGuards struct {
+	mu        sync.Mutex
+	guards    map[*networkGuard]struct{}
+	transport *http.Transport
+}
+
+func (n *networkGuards) add(g *networkGuard) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.guards[g] = struct{}{}
+	n.reset()
+}
+
+func (n *networkGuards) remove(g *networkGuard) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.guards, g)
+	g.transport.CloseIdleConnections()
+	n.reset()
+}
+
+// reset replaces the transport when the guards change, so the connections allowed by the previous guards
+// are not reused. Must be called with the lock acquired.
+func (n *networkGuards) reset() {
+	if n.transport != nil {
+		n.transport.CloseIdleConnections()
+	}
+
+	guards := make([]*networkGuard, 0, len(n.guards))
+	for g := range n.guards {
+		guards = append(guards, g)
+	}
+	n.transport = newGuardedTransport(func(ctx context.Context, network, address string) (net.Conn, error) {
+		if len(guards) == 0 {
+			return nil, fmt.Errorf("plugin is not allowed to connect to %q (%s) by the network policy", address, network)
+		}
+
+		var err error
+		for _, g := range guards {
+			address, err = g.allowedAddress(ctx, network, address)
+			if err != nil {
+				return nil, fmt.Errorf("%w (requests not created by the plugin must be allowed by the network policies of all the plugins)", err)
+			}
+		}
+
+		var d net.Dialer
+		return d.DialContext(ctx, network, address)
+	})
+}
+
+func (n *networkGuards) roundTrip(req *http.Request) (*http.Response, error) {
+	n.mu.Lock()
+	transport := n.transport
+	n.mu.Unlock()
+
+	return transport.RoundTrip(req)
+}
+
+func copySymbols(symbols map[string]reflect.Value) map[string]reflect.Value {
+	c := make(map[string]reflect.Value, len(symbols))
+	for k, v := range symbols {
+		c[k] = v
+	}
+
+	return c
+}
@@ -4,12 +4,13 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"io"
 	"os"
+	"reflect"
+	"strings"
 	"sync"
 
 	"github.com/traefik/yaegi/interp"
-	"github.com/traefik/yaegi/stdlib"
-	"github.com/traefik/yaegi/stdlib/unsafe"
 
 	"github.com/slok/terraform-provider-goplugin/internal/plugin/storage"
 	"github.com/slok/terraform-provider-goplugin/internal/plugin/v1/yaegicustom"
@@ -20,6 +21,9 @@ import (
 type Engine struct {
 	resourcePluginsCache   sync.Map
 	dataSourcePluginsCache sync.Map
+	pluginModulesCache     sync.Map
+	interpretersCache      sync.Map
+	processesCache         sync.Map
 }
 
 // NewEngine returns a new plugin V1 engine.
@@ -37,6 +41,25 @@ type PluginConfig struct {
 	// the plugin source code. It must meet the plugin factory signature.
 	// E.g: NewResourcePlugin, NewDataSourcePlugin...
 	PluginFactoryName string
+	// Stdout is where the plugin standard output will be written, discarded if not set.
+	Stdout io.Writer
+	// Stderr is where the plugin standard error will be written, discarded if not set.
+	Stderr io.Writer
+	// Sandbox is the policy of the standard library packages that the plugin can import, by default all
+	// except `unsafe`.
+	Sandbox SandboxPolicy
+	// Env is the environment of the plugin (in `KEY=value` form), if nil the plugin will inherit the
+	// environment of the provider.
+	Env []string
+	// Network is the policy of the network destinations that the plugin can connect to, by default all.
+	Network NetworkPolicy
+	// Filesystem is the policy of the host filesystem access of the plugin, by default all the filesystem
+	// can be read and written.
+	Filesystem FilesystemPolicy
+	// Execution is where the plugin will be executed, by default in the engine process.
+	Execution ExecutionMode
+	// ProcessLimits are the resource limits of the plugin process when using the process execution mode.
+	ProcessLimits ProcessLimits
 }
 
 func (p *PluginConfig) defaults() error {
@@ -48,6 +71,22 @@ func (p *PluginConfig) defaults() error {
 		return fmt.Errorf("The name of the plugin factory is required")
 	}
 
+	if p.Stdout == nil {
+		p.Stdout = io.Discard
+	}
+
+	if p.Stderr == nil {
+		p.Stderr = io.Discard
+	}
+
+	switch p.Execution {
+	case "":
+		p.Execution = ExecutionModeInProcess
+	case ExecutionModeInProcess, ExecutionModeProcess:
+	default:
+		return fmt.Errorf("unknown %q execution mode", p.Execution)
+	}
+
 	return nil
 }
 
@@ -60,7 +99,7 @@ func (e *Engine) NewResourcePlugin(ctx context.Context, config PluginConfig) (ap
 	}
 
 	// Get plugin from cache if we already have it.
-	index := pluginIndex(ctx, config.SourceCodeRepository, config.PluginOptions, config.PluginFactoryName)
+	index := pluginIndex(ctx, config)
 	p, ok := e.resourcePluginsCache.Load(index)
 	if ok {
 		// Should always be a resource plugin, we control the type internally,
@@ -70,20 +109,31 @@ func (e *Engine) NewResourcePlugin(ctx context.Context, config PluginConfig) (ap
 	}
 
 	// Create Yaegi plugin.
-	pluginFactory, err := loadRawResourcePluginFactory(ctx, config.SourceCodeRepository, config.PluginFactoryName)
-	if err != nil {
-		return nil, fmt.Errorf("could not load plugin: %w", err)
-	}
-
-	plugin, err := pluginFactory(config.PluginOptions)
-	if err != nil {
-		return nil, fmt.Errorf("could not create plugin: %w", err)
+	var plugin apiv1.ResourcePlugin
+	switch config.Execution {
+	case ExecutionModeProcess:
+		plugin, err = e.newProcessResourcePlugin(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("could not load plugin in plugin process: %w", err)
+		}
+
+	default:
+		pluginFactory, err := e.loadRawResourcePluginFactory(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("could not load plugin: %w", err)
+		}
+
+		rawPlugin, err := pluginFactory(config.PluginOptions)
+		if err != nil {
+			return nil, fmt.Errorf("could not create plugin: %w", err)
+		}
+		plugin = newResourcePlugin(rawPlugin, capabilitiesOf(rawPlugin))
 	}
 
-	// Store plugin in cache.
-	e.resourcePluginsCache.Store(index, plugin)
+	// Store plugin in cache, if the same plugin has been created concurrently, use the cached one.
+	p, _ = e.resourcePluginsCache.LoadOrStore(index, plugin)
 
-	return plugin, nil
+	return p.(apiv1.ResourcePlugin), nil
 }
 
 func (e *Engine) NewDataSourcePlugin(ctx context.Context, config PluginConfig) (apiv1.DataSourcePlugin, error) {
@@ -93,7 +143,7 @@ func (e *Engine) NewDataSourcePlugin(ctx context.Context, config PluginConfig) (
 	}
 
 	// Get plugin from cache if we already have it.
-	index := pluginIndex(ctx, config.SourceCodeRepository, config.PluginOptions, config.PluginFactoryName)
+	index := pluginIndex(ctx, config)
 	p, ok := e.dataSourcePluginsCache.Load(index)
 	if ok {
 		// Should always be a data source plugin, we control the type internally,
@@ -103,50 +153,282 @@ func (e *Engine) NewDataSourcePlugin(ctx context.Context, config PluginConfig) (
 	}
 
 	// Create Yaegi plugin.
-	pluginFactory, err := loadRawDataSourcePluginFactory(ctx, config.SourceCodeRepository, config.PluginFactoryName)
+	var plugin apiv1.DataSourcePlugin
+	switch config.Execution {
+	case ExecutionModeProcess:
+		plugin, err = e.newProcessDataSourcePlugin(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("could not load plugin in plugin process: %w", err)
+		}
+
+	default:
+		pluginFactory, err := e.loadRawDataSourcePluginFactory(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("could not load plugin: %w", err)
+		}
+
+		rawPlugin, err := pluginFactory(config.PluginOptions)
+		if err != nil {
+			return nil, fmt.Errorf("could not create plugin: %w", err)
+		}
+		plugin = newDataSourcePlugin(rawPlugin, capabilitiesOf(rawPlugin))
+	}
+
+	// Store plugin in cache, if the same plugin has been created concurrently, use the cached one.
+	p, _ = e.dataSourcePluginsCache.LoadOrStore(index, plugin)
+
+	return p.(apiv1.DataSourcePlugin), nil
+}
+
+// PluginModuleConfig is the configuration that the engine needs to instantiate all the plugins of a plugin module.
+type PluginModuleConfig struct {
+	// SourceCodeRepository is the repository where the plugin engine will get the source code for the plugin module.
+	SourceCodeRepository storage.SourceCodeRepository
+	// PluginOptions are the options that will be passed to all the plugin factories of the module to create the plugins.
+	PluginOptions string
+	// PluginsRegistryName is the name that the plugin engine will search for in the plugins registry function inside
+	// the plugin module source code. It must meet the plugins registry function signature.
+	// By default `apiv1.DefaultPluginsRegistryName`.
+	PluginsRegistryName string
+	// Stdout is where the plugins standard output will be written, discarded if not set.
+	Stdout io.Writer
+	// Stderr is where the plugins standard error will be written, discarded if not set.
+	Stderr io.Writer
+	// Sandbox is the policy of the standard library packages that the plugins can import, by default all
+	// except `unsafe`.
+	Sandbox SandboxPolicy
+	// Env is the environment of the plugins (in `KEY=value` form), if nil the plugins will inherit the
+	// environment of the provider.
+	Env []string
+	// Network is the policy of the network destinations that the plugins can connect to, by default all.
+	Network NetworkPolicy
+	// Filesystem is the policy of the host filesystem access of the plugins, by default all the filesystem
+	// can be read and written.
+	Filesystem FilesystemPolicy
+	// Execution is where the plugins will be executed, by default in the engine process.
+	Execution ExecutionMode
+	// ProcessLimits are the resource limits of the plugin process when using the process execution mode.
+	ProcessLimits ProcessLimits
+}
+
+// pluginConfig returns the plugin configuration used to load the plugins registry.
+func (p PluginModuleConfig) pluginConfig() PluginConfig {
+	return PluginConfig{
+		SourceCodeRepository: p.SourceCodeRepository,
+		PluginOptions:        p.PluginOptions,
+		PluginFactoryName:    p.PluginsRegistryName,
+		Stdout:               p.Stdout,
+		Stderr:               p.Stderr,
+		Sandbox:              p.Sandbox,
+		Env:                  p.Env,
+		Network:              p.Network,
+		Filesystem:           p.Filesystem,
+		Execution:            p.Execution,
+		ProcessLimits:        p.ProcessLimits,
+	}
+}
+
+func (p *PluginModuleConfig) defaults() error {
+	if p.SourceCodeRepository == nil {
+		return fmt.Errorf("source code repository is required")
+	}
+
+	if p.PluginsRegistryName == "" {
+		p.PluginsRegistryName = apiv1.DefaultPluginsRegistryName
+	}
+
+	if p.Stdout == nil {
+		p.Stdout = io.Discard
+	}
+
+	if p.Stderr == nil {
+		p.Stderr = io.Discard
+	}
+
+	switch p.Execution {
+	case "":
+		p.Execution = ExecutionModeInProcess
+	case ExecutionModeInProcess, ExecutionModeProcess:
+	default:
+		return fmt.Errorf("unknown %q execution mode", p.Execution)
+	}
+
+	return nil
+}
+
+// PluginModule has all the plugins registered by a plugin module, indexed by their registry name.
+type PluginModule struct {
+	ResourcePlugins   map[string]apiv1.ResourcePlugin
+	DataSourcePlugins map[string]apiv1.DataSourcePlugin
+}
+
+// NewPluginModule returns all the plugins registered by the plugins registry of a plugin module source code.
+// All the plugins of the module share the same interpreter, so the source code is loaded only once.
+func (e *Engine) NewPluginModule(ctx context.Context, config PluginModuleConfig) (*PluginModule, error) {
+	err := config.defaults()
 	if err != nil {
-		return nil, fmt.Errorf("could not load plugin: %w", err)
+		return nil, fmt.Errorf("invalid plugin module configuration: %w", err)
 	}
 
-	plugin, err := pluginFactory(config.PluginOptions)
+	// Get plugin module from cache if we already have it.
+	index := pluginIndex(ctx, config.pluginConfig())
+	m, ok := e.pluginModulesCache.Load(index)
+	if ok {
+		// Should always be a plugin module, we control the type internally,
+		// panicking its ok, shouldn't happen.
+		module := m.(*PluginModule)
+		return module, nil
+	}
+
+	if config.Execution == ExecutionModeProcess {
+		module, err := e.newProcessPluginModule(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("could not load plugin module in plugin process: %w", err)
+		}
+
+		// Store plugin module in cache, if the same plugin module has been created concurrently, use the cached one.
+		m, _ = e.pluginModulesCache.LoadOrStore(index, module)
+
+		return m.(*PluginModule), nil
+	}
+
+	// Create Yaegi plugins registry.
+	registryFunc, err := e.loadRawPluginsRegistryFunc(ctx, config)
 	if err != nil {
-		return nil, fmt.Errorf("could not create plugin: %w", err)
+		return nil, fmt.Errorf("could not load plugins registry: %w", err)
+	}
+	registry := registryFunc()
+
+	module := &PluginModule{
+		ResourcePlugins:   map[string]apiv1.ResourcePlugin{},
+		DataSourcePlugins: map[string]apiv1.DataSourcePlugin{},
+	}
+	for name, pluginFactory := range registry.ResourcePlugins {
+		plugin, err := pluginFactory(config.PluginOptions)
+		if err != nil {
+			return nil, fmt.Errorf("could not create %q resource plugin: %w", name, err)
+		}
+		module.ResourcePlugins[name] = newResourcePlugin(plugin, capabilitiesOf(plugin))
+	}
+	for name, pluginFactory := range registry.DataSourcePlugins {
+		plugin, err := pluginFactory(config.PluginOptions)
+		if err != nil {
+			return nil, fmt.Errorf("could not create %q data source plugin: %w", name, err)
+		}
+		module.DataSourcePlugins[name] = newDataSourcePlugin(plugin, capabilitiesOf(plugin))
 	}
 
-	// Store plugin in cache.
-	e.resourcePluginsCache.Store(index, plugin)
+	// Store plugin module in cache, if the same plugin module has been created concurrently, use the cached one.
+	m, _ = e.pluginModulesCache.LoadOrStore(index, module)
 
-	return plugin, nil
+	return m.(*PluginModule), nil
 }
 
-func pluginIndex(ctx context.Context, repo storage.SourceCodeRepository, pluginOptions string, factoryName string) string {
-	bundle := repo.Index(ctx) + pluginOptions + factoryName
+// Close closes all the plugins created by the engine that support the `CapabilityCloser` capability,
+// stops the plugin processes and closes the plugins standard streams, the plugins will not be usable after
+// closing them.
+func (e *Engine) Close(ctx context.Context) error {
+	errs := []string{}
+	closePlugin := func(_, p interface{}) bool {
+		if !Supports(p, CapabilityCloser) {
+			return true
+		}
+
+		err := p.(apiv1.Closer).Close(ctx)
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		return true
+	}
+	e.resourcePluginsCache.Range(closePlugin)
+	e.dataSourcePluginsCache.Range(closePlugin)
+	e.pluginModulesCache.Range(func(_, m interface{}) bool {
+		module := m.(*PluginModule)
+		for _, p := range module.ResourcePlugins {
+			closePlugin(nil, p)
+		}
+		for _, p := range module.DataSourcePlugins {
+			closePlugin(nil, p)
+		}
+		return true
+	})
+
+	e.processesCache.Range(func(_, p interface{}) bool {
+		p.(*pluginProcess).stop()
+		return true
+	})
+
+	e.interpretersCache.Range(func(index, pi interface{}) bool {
+		i := pi.(*pluginInterpreter)
+		i.mu.Lock()
+		defer i.mu.Unlock()
+		if i.stdio != nil {
+			i.stdio.Close()
+		}
+		if i.guard != nil {
+			activeNetworkGuards.remove(i.guard)
+		}
+		e.interpretersCache.Delete(index)
+		return true
+	})
+
+	if len(errs) > 0 {
+		return fmt.Errorf("could not close plugins: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func pluginIndex(ctx context.Context, config PluginConfig) string {
+	bundle := interpreterIndex(ctx, config) + config.PluginOptions + config.PluginFactoryName
+	sha := sha256.Sum256([]byte(bundle))
+
+	return fmt.Sprintf("%x", sha)
+}
+
+// interpreterIndex is the index of the plugins that can share the same interpreter. The plugins sharing an
+// interpreter share its standard output and error, so these are part of the index.
+func interpreterIndex(ctx context.Context, config PluginConfig) string {
+	bundle := config.SourceCodeRepository.Index(ctx) + config.Sandbox.index() + config.Network.index() + config.Filesystem.index() + fmt.Sprintf("%t%q", config.Env == nil, config.Env) + string(config.Execution) + config.ProcessLimits.index() + writerIndex(config.Stdout) + writerIndex(config.Stderr)
 	sha := sha256.Sum256([]byte(bundle))
 
 	return fmt.Sprintf("%x", sha)
 }
 
+// writerIndex identifies a writer, the writers with reference types are identified by their address.
+func writerIndex(w io.Writer) string {
+	v := reflect.ValueOf(w)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Chan, reflect.Func, reflect.Slice, reflect.UnsafePointer:
+		return fmt.Sprintf("%T%x", w, v.Pointer())
+	}
+
+	return fmt.Sprintf("%T%v", w, w)
+}
+
 const pluginMemFSDir = "plugin"
 
-func loadRawResourcePluginFactory(ctx context.Context, repo storage.SourceCodeRepository, pluginFactoryName string) (apiv1.ResourcePluginFactory, error) {
-	yaegiInterp, err := newPluginYaegiInterpreter(ctx, repo, pluginMemFSDir)
+func (e *Engine) loadRawResourcePluginFactory(ctx context.Context, config PluginConfig) (apiv1.ResourcePluginFactory, error) {
+	pluginFuncTmp, err := e.loadPluginSymbol(ctx, config, config.PluginFactoryName)
 	if err != nil {
-		return nil, fmt.Errorf("could not create Yaegi interpreter: %w", err)
+		return nil, err
 	}
 
-	importStatement := fmt.Sprintf(`import plugin "%s"`, repo.ImportPath(ctx))
-	_, err = yaegiInterp.EvalWithContext(ctx, importStatement)
-	if err != nil {
-		return nil, fmt.Errorf("could not get plugin: %w", err)
+	pluginFunc, ok := pluginFuncTmp.Interface().(apiv1.ResourcePluginFactory)
+	if !ok {
+		return nil, fmt.Errorf("invalid plugin type")
 	}
 
-	// Get plugin logic.
-	pluginFuncTmp, err := yaegiInterp.EvalWithContext(ctx, "plugin."+pluginFactoryName)
+	return pluginFunc, nil
+}
+
+func (e *Engine) loadRawDataSourcePluginFactory(ctx context.Context, config PluginConfig) (apiv1.DataSourcePluginFactory, error) {
+	pluginFuncTmp, err := e.loadPluginSymbol(ctx, config, config.PluginFactoryName)
 	if err != nil {
-		return nil, fmt.Errorf("could not get plugin: %w", err)
+		return nil, err
 	}
 
-	pluginFunc, ok := pluginFuncTmp.Interface().(apiv1.ResourcePluginFactory)
+	pluginFunc, ok := pluginFuncTmp.Interface().(apiv1.DataSourcePluginFactory)
 	if !ok {
 		return nil, fmt.Errorf("invalid plugin type")
 	}
@@ -154,54 +436,162 @@ func loadRawResourcePluginFactory(ctx context.Context, repo storage.SourceCodeRe
 	return pluginFunc, nil
 }
 
-func loadRawDataSourcePluginFactory(ctx context.Context, repo storage.SourceCodeRepository, pluginFactoryName string) (apiv1.DataSourcePluginFactory, error) {
-	yaegiInterp, err := newPluginYaegiInterpreter(ctx, repo, pluginMemFSDir)
+func (e *Engine) loadRawPluginsRegistryFunc(ctx context.Context, config PluginModuleConfig) (apiv1.PluginsRegistryFunc, error) {
+	registryFuncTmp, err := e.loadPluginSymbol(ctx, config.pluginConfig(), config.PluginsRegistryName)
 	if err != nil {
-		return nil, fmt.Errorf("could not create Yaegi interpreter: %w", err)
+		return nil, err
 	}
 
-	importStatement := fmt.Sprintf(`import plugin "%s"`, repo.ImportPath(ctx))
-	_, err = yaegiInterp.EvalWithContext(ctx, importStatement)
+	registryFunc, ok := registryFuncTmp.Interface().(apiv1.PluginsRegistryFunc)
+	if !ok {
+		return nil, fmt.Errorf("invalid plugins registry type")
+	}
+
+	return registryFunc, nil
+}
+
+// loadPluginSymbol returns a symbol (e.g: a plugin factory) of the plugin source code package.
+func (e *Engine) loadPluginSymbol(ctx context.Context, config PluginConfig, name string) (reflect.Value, error) {
+	pi, err := e.pluginInterpreter(ctx, config)
 	if err != nil {
-		return nil, fmt.Errorf("could not get plugin: %w", err)
+		return reflect.Value{}, err
 	}
 
-	// Get plugin logic.
-	pluginFuncTmp, err := yaegiInterp.EvalWithContext(ctx, "plugin."+pluginFactoryName)
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+
+	symbol, err := pi.interp.EvalWithContext(ctx, "plugin."+name)
 	if err != nil {
-		return nil, fmt.Errorf("could not get plugin: %w", err)
+		return reflect.Value{}, fmt.Errorf("could not get plugin: %w", err)
 	}
 
-	pluginFunc, ok := pluginFuncTmp.Interface().(apiv1.DataSourcePluginFactory)
-	if !ok {
-		return nil, fmt.Errorf("invalid plugin type")
+	return symbol, nil
+}
+
+// pluginInterpreter is a Yaegi interpreter with the plugin source code package already imported.
+type pluginInterpreter struct {
+	mu     sync.Mutex
+	interp *interp.Interpreter
+	stdio  *pluginStdio
+	guard  *networkGuard
+}
+
+// pluginInterpreter returns the interpreter of the plugin source code, the interpreters are shared by all the
+// plugins of the same source code (using the source code index), sandbox and network policies, environment and
+// standard output and error, so the source code (including vendored dependencies) is interpreted only once.
+// The interpreters that fail to load the source code are not reused, the next plugin will try again.
+func (e *Engine) pluginInterpreter(ctx context.Context, config PluginConfig) (*pluginInterpreter, error) {
+	repo := config.SourceCodeRepository
+	pi, _ := e.interpretersCache.LoadOrStore(interpreterIndex(ctx, config), &pluginInterpreter{})
+	// Should always be a plugin interpreter, we control the type internally,
+	// panicking its ok, shouldn't happen.
+	i := pi.(*pluginInterpreter)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.interp != nil {
+		return i, nil
 	}
 
-	return pluginFunc, nil
+	err := config.Filesystem.sandbox(config.Sandbox).checkImports(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("forbidden import: %w", err)
+	}
+
+	var guard *networkGuard
+	if config.Network.enabled() {
+		guard, err = newNetworkGuard(config.Network)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network policy: %w", err)
+		}
+	}
+
+	stdio, err := newPluginStdio(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create plugin stdio: %w", err)
+	}
+
+	yaegiInterp, err := newPluginYaegiInterpreter(ctx, config, pluginMemFSDir, stdio, guard)
+	if err != nil {
+		stdio.Close()
+		return nil, fmt.Errorf("could not create Yaegi interpreter: %w", err)
+	}
+
+	// The network guard is active before the plugin package is initialized (e.g: `init` functions).
+	if guard != nil {
+		activeNetworkGuards.add(guard)
+	}
+
+	importStatement := fmt.Sprintf(`import plugin "%s"`, repo.ImportPath(ctx))
+	_, err = yaegiInterp.EvalWithContext(ctx, importStatement)
+	if err != nil {
+		stdio.Close()
+		if guard != nil {
+			activeNetworkGuards.remove(guard)
+		}
+		return nil, fmt.Errorf("could not get plugin: %w", err)
+	}
+
+	i.interp = yaegiInterp
+	i.stdio = stdio
+	i.guard = guard
+
+	return i, nil
 }
 
 // newPluginReadyYaegiInterpreter will:
 // - Create a new Yaegi interpreter.
 // - Setup a memory based FS with the plugin source code loaded in the specified plugin dir.
-// - Add the required libraries available (standard library and our own library).
-func newPluginYaegiInterpreter(ctx context.Context, repo storage.SourceCodeRepository, pluginDir string) (*interp.Interpreter, error) {
+// - Add the required libraries available (standard library allowed by the sandbox policy and our own library).
+// - Redirect the standard output and error of the plugin, and close the standard input.
+// - Set the environment of the plugin.
+// - Check the plugin network connections with the network guard of the network policy.
+// - Check the plugin filesystem operations with the filesystem policy.
+func newPluginYaegiInterpreter(ctx context.Context, config PluginConfig, pluginDir string, stdio *pluginStdio, guard *networkGuard) (*interp.Interpreter, error) {
+	repo := config.SourceCodeRepository
+
+	env := config.Env
+	if env == nil {
+		env = os.Environ()
+	}
+
 	// Create interpreter
 	i := interp.New(interp.Options{
 		SourcecodeFilesystem: repo.FS(ctx),
-		Env:                  os.Environ(),
+		Env:                  env,
 		GoPath:               repo.Gopath(ctx),
+		Stdin:                stdio.stdin,
+		Stdout:               stdio.stdout,
+		Stderr:               stdio.stderr,
 	})
 
-	// Add standard library.
-	err := i.Use(stdlib.Symbols)
+	// Add standard library (and unsafe library) allowed by the sandbox policy, checking the
+	// network connections and the filesystem operations if required.
+	symbols := config.Filesystem.sandbox(config.Sandbox).symbols()
+	if guard != nil {
+		guard.apply(symbols)
+	}
+	var fsGuard *filesystemGuard
+	if config.Filesystem.enabled() {
+		var err error
+		fsGuard, err = newFilesystemGuard(config.Filesystem)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filesystem policy: %w", err)
+		}
+		fsGuard.apply(symbols)
+	}
+
+	err := i.Use(symbols)
 	if err != nil {
 		return nil, fmt.Errorf("yaegi could not use stdlib symbols: %w", err)
 	}
 
-	// Add unsafe library.
-	err = i.Use(unsafe.Symbols)
-	if err != nil {
-		return nil, fmt.Errorf("yaegi could not use stdlib unsafe symbols: %w", err)
+	// Yaegi sets the standard streams when using the standard library, these must be plugin files.
+	if fsGuard != nil {
+		err = i.Use(fsGuard.stdioSymbols(symbols, stdio))
+		if err != nil {
+			return nil, fmt.Errorf("yaegi could not use stdio symbols: %w", err)
+		}
 	}
 
 	// Add our own plugin library.
@@ -212,3 +602,66 @@ func newPluginYaegiInterpreter(ctx context.Context, repo storage.SourceCodeRepos
 
 	return i, nil
 }
+
+// pluginStdio are the standard streams of a plugin interpreter.
+type pluginStdio struct {
+	stdin   *os.File
+	stdout  *os.File
+	stderr  *os.File
+	closers []io.Closer
+}
+
+// newPluginStdio returns the standard streams of the plugin. Yaegi only redirects `os.Stdin`, `os.Stdout` and
+// `os.Stderr` when these are files, so we use pipes that copy the output to the configured writers, and a closed
+// input so plugins can't block reading it.
+func newPluginStdio(config PluginConfig) (*pluginStdio, error) {
+	stdio := &pluginStdio{}
+	stdin, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	_ = w.Close()
+	stdio.stdin = stdin
+	stdio.closers = append(stdio.closers, stdin)
+
+	stdio.stdout, err = stdio.outputPipe(config.Stdout)
+	if err != nil {
+		stdio.Close()
+		return nil, err
+	}
+
+	stdio.stderr, err = stdio.outputPipe(config.Stderr)
+	if err != nil {
+		stdio.Close()
+		return nil, err
+	}
+
+	return stdio, nil
+}
+
+// outputPipe returns the file where the plugin output is written, copied to the output writer.
+func (p *pluginStdio) outputPipe(out io.Writer) (*os.File, error) {
+	if f, ok := out.(*os.File); ok {
+		return f, nil
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	p.closers = append(p.closers, w)
+
+	go func() {
+		_, _ = io.Copy(out, r)
+		_ = r.Close()
+	}()
+
+	return w, nil
+}
+
+// Close closes the pipes of the plugin standard streams, the output pipes end after copying the pending output.
+func (p *pluginStdio) Close() {
+	for _, c := range p.closers {
+		_ = c.Close()
+	}
+}
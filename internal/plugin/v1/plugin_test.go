@@ -1,24 +1,53 @@
 package v1_test
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"testing/fstest"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/slok/terraform-provider-goplugin/internal/plugin/storage"
 	"github.com/slok/terraform-provider-goplugin/internal/plugin/storage/moduledir"
 	pluginv1 "github.com/slok/terraform-provider-goplugin/internal/plugin/v1"
 	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
 )
 
 var (
-	pluginDirNoop  = "./testdata/plugin_noop"
-	pluginDirError = "./testdata/plugin_error"
-	pluginDirOk    = "./testdata/plugin_ok"
+	pluginDirNoop    = "./testdata/plugin_noop"
+	pluginDirError   = "./testdata/plugin_error"
+	pluginDirOk      = "./testdata/plugin_ok"
+	pluginDirUnsafe  = "./testdata/plugin_unsafe"
+	pluginDirNetwork = "./testdata/plugin_network"
+	pluginDirFS      = "./testdata/plugin_filesystem"
+	pluginDirProcess = "./testdata/plugin_process"
 )
 
+func TestMain(m *testing.M) {
+	// The test binary is the plugin process binary of the process execution mode tests.
+	if pluginv1.IsPluginProcess() {
+		if err := pluginv1.ServePluginProcess(context.Background()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error running plugin process: %s\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
 func TestResourcePluginCreate(t *testing.T) {
 	tests := map[string]struct {
 		pluginDir   string
@@ -42,7 +71,9 @@ func TestResourcePluginCreate(t *testing.T) {
 				Attributes: "this is a test",
 			},
 			expResponse: &apiv1.CreateResourceResponse{
-				ID: "this is a test_test1",
+				ID:      "this is a test_test1",
+				Outputs: "this is a test_outputs",
+				Private: "this is a test_private",
 			},
 		},
 	}
@@ -78,12 +109,154 @@ func TestResourcePluginCreate(t *testing.T) {
 	}
 }
 
+type testLogger struct {
+	values   map[string]interface{}
+	messages *[]string
+}
+
+func (t testLogger) log(level, format string, args ...interface{}) {
+	*t.messages = append(*t.messages, fmt.Sprintf("[%s] %s %v", level, fmt.Sprintf(format, args...), t.values))
+}
+
+func (t testLogger) Debugf(format string, args ...interface{})   { t.log("debug", format, args...) }
+func (t testLogger) Infof(format string, args ...interface{})    { t.log("info", format, args...) }
+func (t testLogger) Warningf(format string, args ...interface{}) { t.log("warning", format, args...) }
+func (t testLogger) Errorf(format string, args ...interface{})   { t.log("error", format, args...) }
+func (t testLogger) WithValues(values map[string]interface{}) apiv1.Logger {
+	vs := map[string]interface{}{}
+	for k, v := range t.values {
+		vs[k] = v
+	}
+	for k, v := range values {
+		vs[k] = v
+	}
+	return testLogger{values: vs, messages: t.messages}
+}
+
+func TestResourcePluginLogger(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir   string
+		request     apiv1.CreateResourceRequest
+		expMessages []string
+	}{
+		"Noop plugin should not log.": {
+			pluginDir: pluginDirNoop,
+		},
+
+		"A plugin using the context logger should log on the provider logger.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.CreateResourceRequest{
+				Attributes: "test1",
+			},
+			expMessages: []string{
+				`[info] Creating resource "test1" map[attributes:test1]`,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
+			require.NoError(err)
+
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+			}
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			var gotMessages []string
+			ctx := apiv1.ContextWithLogger(context.TODO(), testLogger{messages: &gotMessages})
+			_, err = p.CreateResource(ctx, test.request)
+			require.NoError(err)
+
+			assert.Equal(test.expMessages, gotMessages)
+		})
+	}
+}
+
+type testWriter struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (t *testWriter) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.buf.Write(p)
+}
+
+func (t *testWriter) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.buf.String()
+}
+
+func TestResourcePluginOutput(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir string
+		request   apiv1.CreateResourceRequest
+		expStdout string
+		expStderr string
+	}{
+		"Noop plugin should not write output.": {
+			pluginDir: pluginDirNoop,
+		},
+
+		"A plugin using the standard output and error should write on the configured writers.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.CreateResourceRequest{
+				Attributes: "output",
+			},
+			expStdout: "stdout test (stdin: \"\")\n",
+			expStderr: "stderr test\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
+			require.NoError(err)
+
+			var stdout, stderr testWriter
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+				Stdout:               &stdout,
+				Stderr:               &stderr,
+			}
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			_, err = p.CreateResource(context.TODO(), test.request)
+			require.NoError(err)
+
+			// Output is written asynchronously.
+			assert.Eventually(func() bool {
+				return test.expStdout == stdout.String() && test.expStderr == stderr.String()
+			}, time.Second, 10*time.Millisecond)
+			assert.Equal(test.expStdout, stdout.String())
+			assert.Equal(test.expStderr, stderr.String())
+		})
+	}
+}
+
 func TestResourcePluginRead(t *testing.T) {
 	tests := map[string]struct {
 		pluginDir   string
 		request     apiv1.ReadResourceRequest
 		expResponse *apiv1.ReadResourceResponse
 		expErr      bool
+		expErrIs    error
 	}{
 		"Noop plugin should end correctly being a NOOP.": {
 			pluginDir:   pluginDirNoop,
@@ -98,11 +271,33 @@ func TestResourcePluginRead(t *testing.T) {
 		"A correct plugin should return the correct result.": {
 			pluginDir: pluginDirOk,
 			request: apiv1.ReadResourceRequest{
-				ID: "this is a test",
+				ID:              "this is",
+				AttributesState: " a test",
+				Private:         "private",
 			},
 			expResponse: &apiv1.ReadResourceResponse{
 				Attributes: "this is a test_test1",
+				Outputs:    "this is_outputs",
+				Private:    "private_read",
+			},
+		},
+
+		"A plugin returning a wrapped not found error should be identified as not found.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.ReadResourceRequest{
+				ID: "missing",
+			},
+			expErr:   true,
+			expErrIs: apiv1.ErrNotFound,
+		},
+
+		"A plugin returning a retryable error should be identified as retryable.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.ReadResourceRequest{
+				ID: "unavailable",
 			},
+			expErr:   true,
+			expErrIs: apiv1.ErrRetryable,
 		},
 	}
 
@@ -130,6 +325,9 @@ func TestResourcePluginRead(t *testing.T) {
 
 			if test.expErr {
 				assert.Error(err)
+				if test.expErrIs != nil {
+					assert.ErrorIs(err, test.expErrIs)
+				}
 			} else if assert.NoError(err) {
 				assert.Equal(test.expResponse, resp)
 			}
@@ -137,6 +335,104 @@ func TestResourcePluginRead(t *testing.T) {
 	}
 }
 
+func TestResourcePluginRequestInfo(t *testing.T) {
+	tests := map[string]struct {
+		info          *apiv1.RequestInfo
+		expAttributes string
+	}{
+		"A plugin without request info on the context should get an empty request info.": {
+			expAttributes: ",,,,,",
+		},
+
+		"A plugin with request info on the context should get the request info.": {
+			info: &apiv1.RequestInfo{
+				PluginID:        "test_plugin",
+				Operation:       apiv1.OperationRead,
+				ResourceType:    "goplugin_plugin_v1",
+				ResourceID:      "request_info",
+				Workspace:       "prod",
+				ProviderVersion: "v1.2.3",
+			},
+			expAttributes: "test_plugin,read,goplugin_plugin_v1,request_info,prod,v1.2.3",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirOk))
+			require.NoError(err)
+
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+			}
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			ctx := context.TODO()
+			if test.info != nil {
+				ctx = apiv1.ContextWithRequestInfo(ctx, *test.info)
+			}
+			resp, err := p.ReadResource(ctx, apiv1.ReadResourceRequest{ID: "request_info"})
+			require.NoError(err)
+
+			assert.Equal(test.expAttributes, resp.Attributes)
+		})
+	}
+}
+
+func TestResourcePluginEnv(t *testing.T) {
+	t.Setenv("TEST_GOPLUGIN_ENV", "inherited")
+
+	tests := map[string]struct {
+		env       []string
+		expResult string
+	}{
+		"A plugin without environment should inherit the environment.": {
+			env:       nil,
+			expResult: "inherited",
+		},
+
+		"A plugin with an empty environment should not have environment.": {
+			env:       []string{},
+			expResult: "",
+		},
+
+		"A plugin with a custom environment should only have the custom environment.": {
+			env:       []string{"TEST_GOPLUGIN_ENV=custom"},
+			expResult: "custom",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirOk))
+			require.NoError(err)
+
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+				Env:                  test.env,
+			}
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			resp, err := p.ReadResource(context.TODO(), apiv1.ReadResourceRequest{ID: "env:TEST_GOPLUGIN_ENV"})
+			if assert.NoError(err) {
+				assert.Equal(test.expResult, resp.Attributes)
+			}
+		})
+	}
+}
+
 func TestResourcePluginUpdate(t *testing.T) {
 	tests := map[string]struct {
 		pluginDir   string
@@ -161,7 +457,9 @@ func TestResourcePluginUpdate(t *testing.T) {
 				Attributes:      "This is",
 				AttributesState: " a test",
 			},
-			expResponse: &apiv1.UpdateResourceResponse{},
+			expResponse: &apiv1.UpdateResourceResponse{
+				Outputs: "This is_outputs",
+			},
 		},
 	}
 
@@ -216,9 +514,14 @@ func TestResourcePluginDelete(t *testing.T) {
 		"A correct plugin should return the correct result.": {
 			pluginDir: pluginDirOk,
 			request: apiv1.DeleteResourceRequest{
-				ID: "test1",
+				ID:              "test1",
+				AttributesState: "this is a test",
+			},
+			expResponse: &apiv1.DeleteResourceResponse{
+				Diagnostics: []apiv1.Diagnostic{
+					{Severity: apiv1.DiagnosticSeverityWarning, Summary: "Deleted", Detail: "test1 deleted", AttributePath: "test1"},
+				},
 			},
-			expResponse: &apiv1.DeleteResourceResponse{},
 		},
 	}
 
@@ -253,16 +556,17 @@ func TestResourcePluginDelete(t *testing.T) {
 	}
 }
 
-func TestDataSourcePluginRead(t *testing.T) {
+func TestResourcePluginPlan(t *testing.T) {
 	tests := map[string]struct {
-		pluginDir   string
-		request     apiv1.ReadDataSourceRequest
-		expResponse *apiv1.ReadDataSourceResponse
-		expErr      bool
+		pluginDir     string
+		request       apiv1.PlanResourceRequest
+		expNotPlanner bool
+		expResponse   *apiv1.PlanResourceResponse
+		expErr        bool
 	}{
-		"Noop plugin should end correctly being a NOOP.": {
-			pluginDir:   pluginDirNoop,
-			expResponse: &apiv1.ReadDataSourceResponse{},
+		"Noop plugin should not implement the optional planner.": {
+			pluginDir:     pluginDirNoop,
+			expNotPlanner: true,
 		},
 
 		"Error plugin should fail the execution.": {
@@ -272,11 +576,14 @@ func TestDataSourcePluginRead(t *testing.T) {
 
 		"A correct plugin should return the correct result.": {
 			pluginDir: pluginDirOk,
-			request: apiv1.ReadDataSourceRequest{
-				Attributes: "this is a test",
+			request: apiv1.PlanResourceRequest{
+				ID:              "test1",
+				Attributes:      "This is",
+				AttributesState: " a test",
 			},
-			expResponse: &apiv1.ReadDataSourceResponse{
-				Result: "this is a testfrom_data_source",
+			expResponse: &apiv1.PlanResourceResponse{
+				Attributes:      "This is a test",
+				RequiresReplace: []string{"test1"},
 			},
 		},
 	}
@@ -289,19 +596,95 @@ func TestDataSourcePluginRead(t *testing.T) {
 			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
 			require.NoError(err)
 
-			// Create the plugin twice to check the plugin cache.
 			config := pluginv1.PluginConfig{
 				SourceCodeRepository: repo,
 				PluginOptions:        "",
-				PluginFactoryName:    "NewDataSourcePlugin",
+				PluginFactoryName:    "NewResourcePlugin",
 			}
-			engine := pluginv1.NewEngine()
-			_, err = engine.NewDataSourcePlugin(context.TODO(), config)
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
 			require.NoError(err)
-			p, err := engine.NewDataSourcePlugin(context.TODO(), config)
+
+			ok := pluginv1.Supports(p, pluginv1.CapabilityResourcePlanner)
+			if test.expNotPlanner {
+				assert.False(ok)
+				return
+			}
+			require.True(ok)
+			planner := p.(apiv1.ResourcePlanner)
+
+			resp, err := planner.PlanResource(context.TODO(), test.request)
+
+			if test.expErr {
+				assert.Error(err)
+			} else if assert.NoError(err) {
+				assert.Equal(test.expResponse, resp)
+			}
+		})
+	}
+}
+
+func TestResourcePluginAttributesEqual(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir        string
+		request          apiv1.AttributesEqualRequest
+		expNotComparator bool
+		expResponse      *apiv1.AttributesEqualResponse
+		expErr           bool
+	}{
+		"Noop plugin should not implement the optional comparator.": {
+			pluginDir:        pluginDirNoop,
+			expNotComparator: true,
+		},
+
+		"Error plugin should fail the execution.": {
+			pluginDir: pluginDirError,
+			expErr:    true,
+		},
+
+		"A correct plugin with equal attributes should return equal.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.AttributesEqualRequest{
+				Attributes:      "This Is A Test",
+				AttributesState: "this is a test",
+			},
+			expResponse: &apiv1.AttributesEqualResponse{Equal: true},
+		},
+
+		"A correct plugin with different attributes should return not equal.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.AttributesEqualRequest{
+				Attributes:      "This Is A Test",
+				AttributesState: "this is not a test",
+			},
+			expResponse: &apiv1.AttributesEqualResponse{Equal: false},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
 			require.NoError(err)
 
-			resp, err := p.ReadDataSource(context.TODO(), test.request)
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+			}
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			ok := pluginv1.Supports(p, pluginv1.CapabilityAttributesComparator)
+			if test.expNotComparator {
+				assert.False(ok)
+				return
+			}
+			require.True(ok)
+			comparator := p.(apiv1.AttributesComparator)
+
+			resp, err := comparator.AttributesEqual(context.TODO(), test.request)
 
 			if test.expErr {
 				assert.Error(err)
@@ -311,3 +694,1933 @@ func TestDataSourcePluginRead(t *testing.T) {
 		})
 	}
 }
+
+func TestResourcePluginImport(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir      string
+		request        apiv1.ImportResourceRequest
+		expNotImporter bool
+		expResponse    *apiv1.ImportResourceResponse
+		expErr         bool
+	}{
+		"Noop plugin should not implement the optional importer.": {
+			pluginDir:      pluginDirNoop,
+			expNotImporter: true,
+		},
+
+		"Error plugin should fail the execution.": {
+			pluginDir: pluginDirError,
+			expErr:    true,
+		},
+
+		"A correct plugin should return the correct result.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.ImportResourceRequest{
+				ID: "owner:name",
+			},
+			expResponse: &apiv1.ImportResourceResponse{
+				ID:         "owner/name",
+				Attributes: "owner_name",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
+			require.NoError(err)
+
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+			}
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			ok := pluginv1.Supports(p, pluginv1.CapabilityResourceImporter)
+			if test.expNotImporter {
+				assert.False(ok)
+				return
+			}
+			require.True(ok)
+			importer := p.(apiv1.ResourceImporter)
+
+			resp, err := importer.ImportResource(context.TODO(), test.request)
+
+			if test.expErr {
+				assert.Error(err)
+			} else if assert.NoError(err) {
+				assert.Equal(test.expResponse, resp)
+			}
+		})
+	}
+}
+
+func TestResourcePluginUpgradeAttributes(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir      string
+		request        apiv1.UpgradeAttributesRequest
+		expNotUpgrader bool
+		expVersion     int
+		expResponse    *apiv1.UpgradeAttributesResponse
+		expErr         bool
+	}{
+		"Noop plugin should not implement the optional upgrader.": {
+			pluginDir:      pluginDirNoop,
+			expNotUpgrader: true,
+		},
+
+		"Error plugin should fail the execution.": {
+			pluginDir:  pluginDirError,
+			expVersion: 1,
+			expErr:     true,
+		},
+
+		"A correct plugin should return the correct result.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.UpgradeAttributesRequest{
+				FromVersion: 1,
+				Attributes:  "this is a test",
+			},
+			expVersion: 2,
+			expResponse: &apiv1.UpgradeAttributesResponse{
+				Attributes: "this is a test_upgraded",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
+			require.NoError(err)
+
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+			}
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			ok := pluginv1.Supports(p, pluginv1.CapabilityAttributesUpgrader)
+			if test.expNotUpgrader {
+				assert.False(ok)
+				return
+			}
+			require.True(ok)
+			upgrader := p.(apiv1.AttributesUpgrader)
+
+			assert.Equal(test.expVersion, upgrader.AttributesVersion())
+
+			resp, err := upgrader.UpgradeAttributes(context.TODO(), test.request)
+
+			if test.expErr {
+				assert.Error(err)
+			} else if assert.NoError(err) {
+				assert.Equal(test.expResponse, resp)
+			}
+		})
+	}
+}
+
+func TestResourcePluginValidate(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir       string
+		request         apiv1.ValidateAttributesRequest
+		expNotValidator bool
+		expResponse     *apiv1.ValidateAttributesResponse
+		expErr          bool
+	}{
+		"Noop plugin should not implement the optional validator.": {
+			pluginDir:       pluginDirNoop,
+			expNotValidator: true,
+		},
+
+		"Error plugin should fail the execution.": {
+			pluginDir: pluginDirError,
+			expErr:    true,
+		},
+
+		"A correct plugin with valid attributes should not return diagnostics.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.ValidateAttributesRequest{
+				Attributes: "valid",
+			},
+			expResponse: &apiv1.ValidateAttributesResponse{},
+		},
+
+		"A correct plugin with invalid attributes should return diagnostics.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.ValidateAttributesRequest{
+				Attributes: "invalid",
+			},
+			expResponse: &apiv1.ValidateAttributesResponse{
+				Diagnostics: []apiv1.Diagnostic{{Summary: "Invalid", Detail: "invalid attributes", AttributePath: "test1"}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
+			require.NoError(err)
+
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+			}
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			ok := pluginv1.Supports(p, pluginv1.CapabilityAttributesValidator)
+			if test.expNotValidator {
+				assert.False(ok)
+				return
+			}
+			require.True(ok)
+			validator := p.(apiv1.AttributesValidator)
+
+			resp, err := validator.ValidateAttributes(context.TODO(), test.request)
+
+			if test.expErr {
+				assert.Error(err)
+			} else if assert.NoError(err) {
+				assert.Equal(test.expResponse, resp)
+			}
+		})
+	}
+}
+
+func TestResourcePluginHealthCheck(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir           string
+		expNotHealthChecker bool
+		expResponse         *apiv1.HealthCheckResponse
+		expErr              bool
+	}{
+		"Noop plugin should not implement the optional health checker.": {
+			pluginDir:           pluginDirNoop,
+			expNotHealthChecker: true,
+		},
+
+		"Error plugin should fail the execution.": {
+			pluginDir: pluginDirError,
+			expErr:    true,
+		},
+
+		"A correct plugin should return the correct result.": {
+			pluginDir: pluginDirOk,
+			expResponse: &apiv1.HealthCheckResponse{
+				Diagnostics: []apiv1.Diagnostic{{Severity: apiv1.DiagnosticSeverityWarning, Summary: "Healthy", Detail: "healthy test"}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
+			require.NoError(err)
+
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+			}
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			ok := pluginv1.Supports(p, pluginv1.CapabilityHealthChecker)
+			if test.expNotHealthChecker {
+				assert.False(ok)
+				return
+			}
+			require.True(ok)
+			checker := p.(apiv1.HealthChecker)
+
+			resp, err := checker.HealthCheck(context.TODO(), apiv1.HealthCheckRequest{})
+
+			if test.expErr {
+				assert.Error(err)
+			} else if assert.NoError(err) {
+				assert.Equal(test.expResponse, resp)
+			}
+		})
+	}
+}
+
+func TestResourcePluginInfo(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir          string
+		expNotInfoProvider bool
+		expInfo            apiv1.PluginInfo
+	}{
+		"Noop plugin should not implement the optional info provider.": {
+			pluginDir:          pluginDirNoop,
+			expNotInfoProvider: true,
+		},
+
+		"A correct plugin should return the correct result.": {
+			pluginDir: pluginDirOk,
+			expInfo:   apiv1.PluginInfo{Name: "ok", Version: "v1.0.0", Description: "Test plugin", DocsURL: "https://test.test"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
+			require.NoError(err)
+
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+			}
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			ok := pluginv1.Supports(p, pluginv1.CapabilityInfoProvider)
+			if test.expNotInfoProvider {
+				assert.False(ok)
+				return
+			}
+			require.True(ok)
+			infoProvider := p.(apiv1.InfoProvider)
+
+			assert.Equal(test.expInfo, infoProvider.Info())
+		})
+	}
+}
+
+func TestResourcePluginCapabilities(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	repo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirNoop))
+	require.NoError(err)
+
+	p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+		SourceCodeRepository: repo,
+		PluginFactoryName:    "NewResourcePlugin",
+	})
+	require.NoError(err)
+
+	// The plugin without optional interfaces should not support any capability.
+	capabilities := []pluginv1.Capability{
+		pluginv1.CapabilityResourcePlanner,
+		pluginv1.CapabilityAttributesValidator,
+		pluginv1.CapabilityResourceImporter,
+		pluginv1.CapabilityAttributesUpgrader,
+		pluginv1.CapabilityAttributesComparator,
+		pluginv1.CapabilityHealthChecker,
+		pluginv1.CapabilityCloser,
+		pluginv1.CapabilityInfoProvider,
+	}
+	for _, c := range capabilities {
+		assert.False(pluginv1.Supports(p, c))
+	}
+
+	// The unsupported methods should not call the plugin.
+	_, err = p.(apiv1.ResourcePlanner).PlanResource(context.TODO(), apiv1.PlanResourceRequest{})
+	assert.Error(err)
+	assert.NoError(p.(apiv1.Closer).Close(context.TODO()))
+	assert.Equal(0, p.(apiv1.AttributesUpgrader).AttributesVersion())
+}
+
+func TestEngineClose(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir string
+		expStdout string
+		expErr    bool
+	}{
+		"Noop plugin should not implement the optional closer.": {
+			pluginDir: pluginDirNoop,
+		},
+
+		"Error plugin should fail the execution.": {
+			pluginDir: pluginDirError,
+			expErr:    true,
+		},
+
+		"A correct plugin should be closed.": {
+			pluginDir: pluginDirOk,
+			expStdout: "closed\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
+			require.NoError(err)
+
+			var stdout testWriter
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+				Stdout:               &stdout,
+			}
+			engine := pluginv1.NewEngine()
+			_, err = engine.NewResourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			err = engine.Close(context.TODO())
+
+			if test.expErr {
+				assert.Error(err)
+			} else if assert.NoError(err) {
+				// Output is written asynchronously.
+				assert.Eventually(func() bool { return test.expStdout == stdout.String() }, time.Second, 10*time.Millisecond)
+				assert.Equal(test.expStdout, stdout.String())
+			}
+		})
+	}
+}
+
+func TestPluginModule(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir      string
+		registryName   string
+		execution      pluginv1.ExecutionMode
+		expResources   []string
+		expDataSources []string
+		expErr         bool
+	}{
+		"A plugin module without registry should fail.": {
+			pluginDir: pluginDirNoop,
+			expErr:    true,
+		},
+
+		"A plugin module with an invalid registry name should fail.": {
+			pluginDir:    pluginDirOk,
+			registryName: "NewResourcePlugin",
+			expErr:       true,
+		},
+
+		"A correct plugin module should return all the registered plugins.": {
+			pluginDir:      pluginDirOk,
+			expResources:   []string{"ok"},
+			expDataSources: []string{"ok"},
+		},
+
+		"A plugin module without registry in a plugin process should fail.": {
+			pluginDir: pluginDirNoop,
+			execution: pluginv1.ExecutionModeProcess,
+			expErr:    true,
+		},
+
+		"A correct plugin module in a plugin process should return all the registered plugins.": {
+			pluginDir:      pluginDirOk,
+			execution:      pluginv1.ExecutionModeProcess,
+			expResources:   []string{"ok"},
+			expDataSources: []string{"ok"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
+			require.NoError(err)
+
+			// Create the plugin module twice to check the plugin module cache.
+			config := pluginv1.PluginModuleConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginsRegistryName:  test.registryName,
+				Execution:            test.execution,
+			}
+			engine := pluginv1.NewEngine()
+			defer engine.Close(context.TODO())
+			_, err = engine.NewPluginModule(context.TODO(), config)
+			if test.expErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			m, err := engine.NewPluginModule(context.TODO(), config)
+			require.NoError(err)
+
+			gotResources := []string{}
+			for name, p := range m.ResourcePlugins {
+				gotResources = append(gotResources, name)
+				_, err := p.ReadResource(context.TODO(), apiv1.ReadResourceRequest{ID: "test"})
+				assert.NoError(err)
+			}
+			gotDataSources := []string{}
+			for name, p := range m.DataSourcePlugins {
+				gotDataSources = append(gotDataSources, name)
+				resp, err := p.ReadDataSource(context.TODO(), apiv1.ReadDataSourceRequest{Attributes: "test"})
+				if assert.NoError(err) {
+					assert.Equal("testfrom_data_source", resp.Result)
+				}
+			}
+			assert.ElementsMatch(test.expResources, gotResources)
+			assert.ElementsMatch(test.expDataSources, gotDataSources)
+		})
+	}
+}
+
+func TestEngineSharedInterpreter(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	repo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirOk))
+	require.NoError(err)
+
+	// Load different plugins from the same source code, the first two with the same output.
+	var stdout1, stdout2 testWriter
+	engine := pluginv1.NewEngine()
+	_, err = engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+		SourceCodeRepository: repo,
+		PluginFactoryName:    "NewResourcePlugin",
+		Stdout:               &stdout1,
+	})
+	require.NoError(err)
+	_, err = engine.NewDataSourcePlugin(context.TODO(), pluginv1.PluginConfig{
+		SourceCodeRepository: repo,
+		PluginFactoryName:    "NewDataSourcePlugin",
+		Stdout:               &stdout1,
+	})
+	require.NoError(err)
+	_, err = engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+		SourceCodeRepository: repo,
+		PluginFactoryName:    "NewResourcePlugin",
+		PluginOptions:        "{}",
+		Stdout:               &stdout2,
+	})
+	require.NoError(err)
+
+	// The plugins with the same output share the interpreter, the output of each plugin goes to its own output.
+	err = engine.Close(context.TODO())
+	require.NoError(err)
+	assert.Eventually(func() bool { return stdout1.String() == "closed\nclosed\n" }, time.Second, 10*time.Millisecond)
+	assert.Eventually(func() bool { return stdout2.String() == "closed\n" }, time.Second, 10*time.Millisecond)
+}
+
+// testFailingRepository is a source code repository that returns an empty source code while failing.
+type testFailingRepository struct {
+	storage.SourceCodeRepository
+	failing bool
+}
+
+func (t *testFailingRepository) FS(ctx context.Context) fs.FS {
+	if t.failing {
+		return fstest.MapFS{}
+	}
+	return t.SourceCodeRepository.FS(ctx)
+}
+
+func TestEngineFailedLoadRetry(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	okRepo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirOk))
+	require.NoError(err)
+	repo := &testFailingRepository{SourceCodeRepository: okRepo, failing: true}
+	config := pluginv1.PluginConfig{
+		SourceCodeRepository: repo,
+		PluginFactoryName:    "NewResourcePlugin",
+	}
+
+	// The failed load should not be reused by the next plugin of the same source code.
+	engine := pluginv1.NewEngine()
+	_, err = engine.NewResourcePlugin(context.TODO(), config)
+	assert.Error(err)
+
+	repo.failing = false
+	_, err = engine.NewResourcePlugin(context.TODO(), config)
+	assert.NoError(err)
+}
+
+func TestPluginSandbox(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir string
+		sandbox   pluginv1.SandboxPolicy
+		expErr    bool
+	}{
+		"A plugin without sandbox policy should be able to import all the standard library.": {
+			pluginDir: pluginDirOk,
+		},
+
+		"A plugin importing a denied package should fail.": {
+			pluginDir: pluginDirOk,
+			sandbox:   pluginv1.SandboxPolicy{DeniedImports: []string{"fmt"}},
+			expErr:    true,
+		},
+
+		"A plugin importing a package from a denied package tree should fail.": {
+			pluginDir: pluginDirOk,
+			sandbox:   pluginv1.SandboxPolicy{DeniedImports: []string{"io/..."}},
+			expErr:    true,
+		},
+
+		"A plugin importing a package that is not allowed should fail.": {
+			pluginDir: pluginDirOk,
+			sandbox:   pluginv1.SandboxPolicy{AllowedImports: []string{"context", "fmt"}},
+			expErr:    true,
+		},
+
+		"A plugin importing only allowed packages should load correctly.": {
+			pluginDir: pluginDirOk,
+			sandbox:   pluginv1.SandboxPolicy{AllowedImports: []string{"context", "fmt", "io/...", "os", "strings"}},
+		},
+
+		"A plugin importing a package that is allowed and denied should fail.": {
+			pluginDir: pluginDirOk,
+			sandbox:   pluginv1.SandboxPolicy{AllowedImports: []string{"context", "fmt", "io/...", "os", "strings"}, DeniedImports: []string{"os"}},
+			expErr:    true,
+		},
+
+		"A plugin importing unsafe without allowing unsafe should fail.": {
+			pluginDir: pluginDirUnsafe,
+			expErr:    true,
+		},
+
+		"A plugin importing unsafe allowing unsafe should load correctly.": {
+			pluginDir: pluginDirUnsafe,
+			sandbox:   pluginv1.SandboxPolicy{AllowUnsafe: true},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
+			require.NoError(err)
+
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+				Sandbox:              test.sandbox,
+			}
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
+
+			if test.expErr {
+				assert.Error(err)
+			} else if assert.NoError(err) {
+				_, err := p.ReadResource(context.TODO(), apiv1.ReadResourceRequest{})
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestPluginNetwork(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { _, _ = w.Write([]byte("ok")) }))
+	defer srv.Close()
+	srvAddr := srv.Listener.Addr().String()
+	_, srvPort, _ := net.SplitHostPort(srvAddr)
+
+	tests := map[string]struct {
+		allowedHosts []string
+		ids          []string
+		expResult    string
+		expLoadErr   bool
+		expErr       string
+	}{
+		"Without network policy the plugin should connect to any host.": {
+			allowedHosts: nil,
+			ids:          []string{"get:" + srv.URL, "client:" + srv.URL, "notransport:" + srv.URL, "notransportget:" + srv.URL},
+			expResult:    "ok",
+		},
+
+		"An allowed IP should be connected.": {
+			allowedHosts: []string{"127.0.0.1"},
+			ids:          []string{"get:" + srv.URL, "client:" + srv.URL, "notransport:" + srv.URL, "notransportget:" + srv.URL},
+			expResult:    "ok",
+		},
+
+		"An allowed IP and port should be connected.": {
+			allowedHosts: []string{srvAddr},
+			ids:          []string{"get:" + srv.URL, "client:" + srv.URL, "dial:" + srvAddr},
+		},
+
+		"An allowed CIDR should be connected.": {
+			allowedHosts: []string{"10.0.0.0/8", "127.0.0.0/8:" + srvPort},
+			ids:          []string{"get:" + srv.URL, "client:" + srv.URL, "dial:" + srvAddr},
+		},
+
+		"An allowed hostname should be connected.": {
+			allowedHosts: []string{"localhost"},
+			ids:          []string{"get:http://localhost:" + srvPort, "client:http://localhost:" + srvPort, "dial:localhost:" + srvPort, "lookup:localhost"},
+		},
+
+		"A hostname resolved to an allowed IP should be connected.": {
+			allowedHosts: []string{"127.0.0.1", "::1"},
+			ids:          []string{"get:http://localhost:" + srvPort, "dial:localhost:" + srvPort},
+		},
+
+		"A not allowed IP should be denied.": {
+			allowedHosts: []string{"10.0.0.0/8"},
+			ids:          []string{"get:" + srv.URL, "client:" + srv.URL, "notransport:" + srv.URL, "notransportget:" + srv.URL, "dial:" + srvAddr, "reflect:" + srv.URL},
+			expErr:       `plugin "test" is not allowed to connect to "` + srvAddr + `" (tcp) by the network policy`,
+		},
+
+		"A not allowed port should be denied.": {
+			allowedHosts: []string{"127.0.0.1:1"},
+			ids:          []string{"get:" + srv.URL, "client:" + srv.URL, "dial:" + srvAddr},
+			expErr:       `plugin "test" is not allowed to connect to "` + srvAddr + `" (tcp) by the network policy`,
+		},
+
+		"A not allowed hostname should be denied.": {
+			allowedHosts: []string{"*.localhost"},
+			ids:          []string{"get:http://localhost:" + srvPort, "dial:localhost:" + srvPort},
+			expErr:       `plugin "test" is not allowed to connect to "localhost:` + srvPort + `" (tcp) by the network policy`,
+		},
+
+		"A not allowed hostname should not be resolved.": {
+			allowedHosts: []string{"127.0.0.1", "*.localhost"},
+			ids:          []string{"lookup:localhost", "lookup:example.com"},
+			expErr:       `is not allowed to resolve`,
+		},
+
+		"An allowed IP should be resolved.": {
+			allowedHosts: []string{"127.0.0.0/8"},
+			ids:          []string{"lookup:127.0.0.1"},
+			expResult:    "127.0.0.1",
+		},
+
+		"An empty network policy should deny all.": {
+			allowedHosts: []string{},
+			ids:          []string{"get:" + srv.URL, "client:" + srv.URL, "notransport:" + srv.URL, "notransportget:" + srv.URL, "dial:" + srvAddr},
+			expErr:       "by the network policy",
+		},
+
+		"An invalid network policy should fail loading the plugin.": {
+			allowedHosts: []string{"10.0.0.0/99"},
+			expLoadErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirNetwork))
+			require.NoError(err)
+
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+				Network:              pluginv1.NetworkPolicy{Name: "test", AllowedHosts: test.allowedHosts},
+			}
+			engine := pluginv1.NewEngine()
+			defer engine.Close(context.TODO())
+			p, err := engine.NewResourcePlugin(context.TODO(), config)
+			if test.expLoadErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			for _, id := range test.ids {
+				resp, err := p.ReadResource(context.TODO(), apiv1.ReadResourceRequest{ID: id})
+				if test.expErr != "" {
+					assert.ErrorContains(err, test.expErr, id)
+				} else if assert.NoError(err, id) && test.expResult != "" {
+					assert.Equal(test.expResult, resp.Attributes, id)
+				}
+			}
+		})
+	}
+}
+
+func TestPluginNetworkClientWithoutTransport(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { _, _ = w.Write([]byte("ok")) }))
+	defer srv.Close()
+
+	repo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirNetwork))
+	require.NoError(err)
+
+	// Load plugins with different network policies in the same process.
+	engine := pluginv1.NewEngine()
+	defer engine.Close(context.TODO())
+	pAllowed, err := engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+		SourceCodeRepository: repo,
+		PluginFactoryName:    "NewResourcePlugin",
+		Network:              pluginv1.NetworkPolicy{Name: "allowed", AllowedHosts: []string{"127.0.0.1"}},
+	})
+	require.NoError(err)
+	_, err = engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+		SourceCodeRepository: repo,
+		PluginFactoryName:    "NewResourcePlugin",
+		Network:              pluginv1.NetworkPolicy{Name: "denied", AllowedHosts: []string{"10.0.0.0/8"}},
+	})
+	require.NoError(err)
+
+	// The requests created by the plugin should use the plugin network policy.
+	resp, err := pAllowed.ReadResource(context.TODO(), apiv1.ReadResourceRequest{ID: "notransport:" + srv.URL})
+	if assert.NoError(err) {
+		assert.Equal("ok", resp.Attributes)
+	}
+
+	// The other requests should be allowed by the network policies of all the plugins.
+	_, err = pAllowed.ReadResource(context.TODO(), apiv1.ReadResourceRequest{ID: "notransportget:" + srv.URL})
+	assert.ErrorContains(err, `plugin "denied" is not allowed to connect`)
+}
+
+func TestPluginNetworkDeniedSymbols(t *testing.T) {
+	tests := map[string]struct {
+		code   string
+		expErr string
+	}{
+		"Packet listeners should not be available.": {
+			code:   `_, _ = net.ListenPacket("udp", "127.0.0.1:0")`,
+			expErr: `has no symbol ListenPacket`,
+		},
+
+		"UDP listeners should not be available.": {
+			code:   `_, _ = net.ListenUDP("udp", nil)`,
+			expErr: `has no symbol ListenUDP`,
+		},
+
+		"Resolvers should not be available.": {
+			code:   `_ = &net.Resolver{PreferGo: true}`,
+			expErr: `undefined type`,
+		},
+
+		"The default resolver should not be available.": {
+			code:   `_, _ = net.DefaultResolver.LookupHost(context.TODO(), "localhost")`,
+			expErr: `has no symbol DefaultResolver`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(fstest.MapFS{
+				"go.mod": &fstest.MapFile{Data: []byte("module test\n")},
+				"plugin.go": &fstest.MapFile{Data: []byte(`package tf
+
+import (
+	"context"
+	"net"
+)
+
+func Use() {
+	` + test.code + `
+}
+
+var _ = context.TODO
+`)},
+			})
+			require.NoError(err)
+
+			// Without network policy the symbol should be available.
+			engine := pluginv1.NewEngine()
+			defer engine.Close(context.TODO())
+			_, err = engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginFactoryName:    "Use",
+			})
+			assert.ErrorContains(err, "invalid plugin type")
+
+			// With network policy the plugin should not compile.
+			_, err = engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginFactoryName:    "Use",
+				Network:              pluginv1.NetworkPolicy{Name: "test", AllowedHosts: []string{}},
+			})
+			assert.ErrorContains(err, test.expErr)
+		})
+	}
+}
+
+func TestPluginFilesystemDeniedSymbols(t *testing.T) {
+	tests := map[string]struct {
+		imports  string
+		code     string
+		root     bool
+		readOnly bool
+		expErr   string
+	}{
+		"With read-only, processes should not be started.": {
+			imports:  `"os"`,
+			code:     `_, _ = os.StartProcess("/bin/sh", []string{"sh", "-c", "touch /tmp/file"}, &os.ProcAttr{})`,
+			readOnly: true,
+			expErr:   `has no symbol StartProcess`,
+		},
+
+		"With a root, processes should not be started.": {
+			imports: `"os"`,
+			code:    `_, _ = os.StartProcess("/bin/sh", []string{"sh", "-c", "touch /tmp/file"}, &os.ProcAttr{})`,
+			root:    true,
+			expErr:  `has no symbol StartProcess`,
+		},
+
+		"With read-only, templates should not parse host files.": {
+			imports:  `"text/template"`,
+			code:     `_, _ = template.ParseFiles("/etc/hostname")`,
+			readOnly: true,
+			expErr:   `imports "text/template" package that is not allowed`,
+		},
+
+		"With a root, HTML templates should not parse host files.": {
+			imports: `"html/template"`,
+			code:    `_, _ = template.ParseGlob("/etc/*")`,
+			root:    true,
+			expErr:  `imports "html/template" package that is not allowed`,
+		},
+
+		"With read-only, packages should not be imported from the host.": {
+			imports:  `"go/build"`,
+			code:     `_, _ = build.ImportDir("/", 0)`,
+			readOnly: true,
+			expErr:   `imports "go/build" package that is not allowed`,
+		},
+
+		"With a root, the packages importer should not be used.": {
+			imports: `"go/importer"`,
+			code:    `_ = importer.Default()`,
+			root:    true,
+			expErr:  `imports "go/importer" package that is not allowed`,
+		},
+
+		"With read-only, zip files should not be opened from the host.": {
+			imports:  `"archive/zip"`,
+			code:     `_, _ = zip.OpenReader("/tmp/file.zip")`,
+			readOnly: true,
+			expErr:   `has no symbol OpenReader`,
+		},
+
+		"With a root, ELF files should not be opened from the host.": {
+			imports: `"debug/elf"`,
+			code:    `_, _ = elf.Open("/bin/sh")`,
+			root:    true,
+			expErr:  `has no symbol Open`,
+		},
+
+		"With read-only, Mach-O files should not be opened from the host.": {
+			imports:  `"debug/macho"`,
+			code:     `_, _ = macho.OpenFat("/bin/sh")`,
+			readOnly: true,
+			expErr:   `has no symbol OpenFat`,
+		},
+
+		"With a root, PE files should not be opened from the host.": {
+			imports: `"debug/pe"`,
+			code:    `_, _ = pe.Open("/bin/sh")`,
+			root:    true,
+			expErr:  `has no symbol Open`,
+		},
+
+		"With read-only, build info should not be read from the host.": {
+			imports:  `"debug/buildinfo"`,
+			code:     `_, _ = buildinfo.ReadFile("/bin/sh")`,
+			readOnly: true,
+			expErr:   `has no symbol ReadFile`,
+		},
+
+		"With a root, certificates should not be loaded from the host.": {
+			imports: `"crypto/tls"`,
+			code:    `_, _ = tls.LoadX509KeyPair("/tmp/cert.pem", "/tmp/key.pem")`,
+			root:    true,
+			expErr:  `has no symbol LoadX509KeyPair`,
+		},
+
+		"With read-only, host directories should not be served.": {
+			imports:  `"net/http"`,
+			code:     `_ = http.FileServer(http.Dir("/"))`,
+			readOnly: true,
+			expErr:   `has no symbol Dir`,
+		},
+
+		"With a root, host files should not be served.": {
+			imports: `"net/http"`,
+			code:    `http.ServeFile(nil, nil, "/etc/hostname")`,
+			root:    true,
+			expErr:  `has no symbol ServeFile`,
+		},
+
+		"With read-only, host directories should not be parsed.": {
+			imports: `"go/parser"
+	"go/token"`,
+			code:     `_, _ = parser.ParseDir(token.NewFileSet(), "/tmp", nil, 0)`,
+			readOnly: true,
+			expErr:   `has no symbol ParseDir`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(fstest.MapFS{
+				"go.mod": &fstest.MapFile{Data: []byte("module test\n")},
+				"plugin.go": &fstest.MapFile{Data: []byte(`package tf
+
+import (
+	` + test.imports + `
+)
+
+func Use() {
+	` + test.code + `
+}
+`)},
+			})
+			require.NoError(err)
+
+			// Without filesystem policy the symbol should be available.
+			engine := pluginv1.NewEngine()
+			defer engine.Close(context.TODO())
+			_, err = engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginFactoryName:    "Use",
+			})
+			assert.ErrorContains(err, "invalid plugin type")
+
+			// With filesystem policy the plugin should not compile.
+			policy := pluginv1.FilesystemPolicy{Name: "test", ReadOnly: test.readOnly}
+			if test.root {
+				policy.Root = t.TempDir()
+			}
+			_, err = engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginFactoryName:    "Use",
+				Filesystem:           policy,
+			})
+			assert.ErrorContains(err, test.expErr)
+		})
+	}
+}
+
+func TestPluginFilesystem(t *testing.T) {
+	// Prepare a filesystem with a root and files inside and outside of it.
+	newFilesystem := func(t *testing.T) (dir string) {
+		dir = t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "root", "data"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "outside.txt"), []byte("outside"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "root", "data", "file.txt"), []byte("inside"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "outside.go"), []byte("package outside\n"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "root", "file.go"), []byte("package inside\n"), 0o644))
+		require.NoError(t, os.Symlink(filepath.Join(dir, "outside.txt"), filepath.Join(dir, "root", "link-out")))
+		require.NoError(t, os.Symlink(filepath.Join(dir, "missing.txt"), filepath.Join(dir, "root", "link-dangling")))
+		require.NoError(t, os.Symlink("data/file.txt", filepath.Join(dir, "root", "link-in")))
+		return dir
+	}
+
+	tests := map[string]struct {
+		root        bool
+		missingRoot bool
+		readOnly    bool
+		ids         func(dir string) []string
+		expResult   string
+		expLoadErr  bool
+		expErr      string
+		expFile     func(dir string) (path, content string)
+	}{
+		"Without filesystem policy the plugin should access the host filesystem.": {
+			ids: func(dir string) []string {
+				return []string{"read:" + filepath.Join(dir, "outside.txt"), "ioutil-read:" + filepath.Join(dir, "outside.txt")}
+			},
+			expResult: "outside",
+		},
+
+		"With a root, the plugin paths should be relative to the root.": {
+			root: true,
+			ids: func(dir string) []string {
+				return []string{"read:/data/file.txt", "read:data/file.txt", "ioutil-read:/data/file.txt", "fs-read:data/file.txt", "read:/link-in"}
+			},
+			expResult: "inside",
+		},
+
+		"With a root, the plugin should walk the paths relative to the root.": {
+			root:      true,
+			ids:       func(dir string) []string { return []string{"walk:/data"} },
+			expResult: "/data,/data/file.txt",
+		},
+
+		"With a root, the plugin should write inside the root.": {
+			root:      true,
+			ids:       func(dir string) []string { return []string{"write:/data/new.txt"} },
+			expResult: "written",
+			expFile: func(dir string) (string, string) {
+				return filepath.Join(dir, "root", "data", "new.txt"), "written"
+			},
+		},
+
+		"With a root, paths escaping the root should be denied.": {
+			root: true,
+			ids: func(dir string) []string {
+				return []string{"read:../outside.txt", "read:/data/../../outside.txt", "ioutil-read:../outside.txt", "write:../new.txt", "walk:..", "parse:../outside.go",
+					"stat:../outside.txt", "mkdirall:../new.txt", "remove:../outside.txt", "rename:../outside.txt", "chtimes:../outside.txt"}
+			},
+			expErr: `path escapes the root by plugin "test" filesystem policy: permission denied`,
+		},
+
+		"With a root, symlinks escaping the root should be denied.": {
+			root: true,
+			ids: func(dir string) []string {
+				return []string{"read:/link-out", "fs-read:link-out", "write:/link-out", "write:/link-dangling", "stat:/link-out", "truncate:/link-out", "mkdirall:/link-dangling/new.txt"}
+			},
+			expErr: `symlink escapes the root by plugin "test" filesystem policy: permission denied`,
+		},
+
+		"With a root, the plugin should parse the Go files inside the root.": {
+			root:      true,
+			ids:       func(dir string) []string { return []string{"parse:/file.go"} },
+			expResult: "inside",
+		},
+
+		"With a root, the plugin should stat the paths following the symlinks inside the root.": {
+			root:      true,
+			ids:       func(dir string) []string { return []string{"stat:/link-in"} },
+			expResult: "link-in ----------",
+		},
+
+		"With a root, the plugin should stat the symlinks inside the root.": {
+			root:      true,
+			ids:       func(dir string) []string { return []string{"lstat:/link-in"} },
+			expResult: "link-in L---------",
+		},
+
+		"With a root, the plugin should read the symlinks inside the root.": {
+			root:      true,
+			ids:       func(dir string) []string { return []string{"readlink:/link-in"} },
+			expResult: "data/file.txt",
+		},
+
+		"With a root, the plugin should create the directories inside the root.": {
+			root:      true,
+			ids:       func(dir string) []string { return []string{"mkdirall:/data/a/b", "mkdirall:/data/a/b"} },
+			expResult: "b d---------",
+		},
+
+		"With a root, the plugin should rename the files inside the root.": {
+			root:      true,
+			ids:       func(dir string) []string { return []string{"rename:/data/file.txt"} },
+			expResult: "inside",
+			expFile: func(dir string) (string, string) {
+				return filepath.Join(dir, "root", "data", "file.txt.renamed"), "inside"
+			},
+		},
+
+		"With a root, the plugin should truncate the files inside the root.": {
+			root:      true,
+			ids:       func(dir string) []string { return []string{"truncate:/link-in"} },
+			expResult: "in",
+			expFile: func(dir string) (string, string) {
+				return filepath.Join(dir, "root", "data", "file.txt"), "in"
+			},
+		},
+
+		"With a root, the plugin should change the times of the files inside the root.": {
+			root:      true,
+			ids:       func(dir string) []string { return []string{"chtimes:/data/file.txt", "chtimes:/data"} },
+			expResult: "2000-01-01T00:00:00Z",
+		},
+
+		"With a root, the plugin should remove the paths inside the root.": {
+			root: true,
+			ids: func(dir string) []string {
+				return []string{"remove:/link-in", "remove:/data/file.txt", "removeall:/data"}
+			},
+			expResult: "removed",
+		},
+
+		"With a root, the plugin file names should be the plugin paths.": {
+			root: true,
+			ids: func(dir string) []string {
+				return []string{"name:/data/file.txt", "chmod:/data/file.txt", "chown:/data/file.txt"}
+			},
+			expResult: "/data/file.txt",
+		},
+
+		"With a root, the plugin temporary files should be created inside the root.": {
+			root:      true,
+			ids:       func(dir string) []string { return []string{"createtemp:/data"} },
+			expResult: "/data",
+		},
+
+		"With a root, changing the working directory with a file should be denied.": {
+			root:   true,
+			ids:    func(dir string) []string { return []string{"chdir:/data"} },
+			expErr: `working directory change denied by plugin "test" filesystem policy: permission denied`,
+		},
+
+		"With read-only, the plugin should read the filesystem.": {
+			readOnly:  true,
+			ids:       func(dir string) []string { return []string{"read:" + filepath.Join(dir, "outside.txt")} },
+			expResult: "outside",
+		},
+
+		"With read-only, the plugin writes should be denied.": {
+			readOnly: true,
+			ids: func(dir string) []string {
+				return []string{"write:" + filepath.Join(dir, "new.txt"), "mkdirall:" + filepath.Join(dir, "new.txt"), "remove:" + filepath.Join(dir, "outside.txt"),
+					"rename:" + filepath.Join(dir, "outside.txt"), "truncate:" + filepath.Join(dir, "outside.txt"), "chtimes:" + filepath.Join(dir, "outside.txt")}
+			},
+			expErr: `write denied by plugin "test" filesystem policy: permission denied`,
+		},
+
+		"With read-only, the plugin file writes should be denied.": {
+			readOnly: true,
+			ids: func(dir string) []string {
+				return []string{"chmod:" + filepath.Join(dir, "outside.txt"), "chown:" + filepath.Join(dir, "outside.txt")}
+			},
+			expErr: `write denied by plugin "test" filesystem policy: permission denied`,
+		},
+
+		"With a root and read-only, the plugin writes should be denied.": {
+			root:     true,
+			readOnly: true,
+			ids: func(dir string) []string {
+				return []string{"write:/data/new.txt", "createtemp:/data", "chmod:/data/file.txt"}
+			},
+			expErr: `write denied by plugin "test" filesystem policy: permission denied`,
+		},
+
+		"A missing root should fail loading the plugin.": {
+			missingRoot: true,
+			expLoadErr:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			dir := newFilesystem(t)
+			policy := pluginv1.FilesystemPolicy{Name: "test", ReadOnly: test.readOnly}
+			if test.root {
+				policy.Root = filepath.Join(dir, "root")
+			}
+			if test.missingRoot {
+				policy.Root = filepath.Join(dir, "missing")
+			}
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirFS))
+			require.NoError(err)
+
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewResourcePlugin",
+				Filesystem:           policy,
+			}
+			p, err := pluginv1.NewEngine().NewResourcePlugin(context.TODO(), config)
+			if test.expLoadErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			for _, id := range test.ids(dir) {
+				resp, err := p.ReadResource(context.TODO(), apiv1.ReadResourceRequest{ID: id})
+				if test.expErr != "" {
+					assert.ErrorContains(err, test.expErr, id)
+				} else if assert.NoError(err, id) && test.expResult != "" {
+					assert.Equal(test.expResult, resp.Attributes, id)
+				}
+			}
+
+			if test.expFile != nil {
+				path, expContent := test.expFile(dir)
+				content, err := os.ReadFile(path)
+				require.NoError(err)
+				assert.Equal(expContent, string(content))
+			}
+
+			// Nothing should have been written outside the root.
+			_, err = os.Stat(filepath.Join(dir, "new.txt"))
+			assert.True(os.IsNotExist(err) || !test.root && !test.readOnly)
+			_, err = os.Stat(filepath.Join(dir, "missing.txt"))
+			assert.True(os.IsNotExist(err))
+		})
+	}
+}
+
+func TestOpenFileBeneath(t *testing.T) {
+	// Prepare a root where a resolved directory has been replaced with a symlink that escapes the root.
+	dir := t.TempDir()
+	root := filepath.Join(dir, "root")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "data"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "outside"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "data", "file.txt"), []byte("inside"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "outside", "file.txt"), []byte("outside"), 0o644))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "outside"), filepath.Join(root, "replaced")))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "outside", "file.txt"), filepath.Join(root, "file-link")))
+
+	tests := map[string]struct {
+		rel        string
+		flag       int
+		expContent string
+		expErr     bool
+	}{
+		"A path inside the root should be opened.": {
+			rel:        "data/file.txt",
+			expContent: "inside",
+		},
+
+		"A directory replaced with a symlink should not be followed.": {
+			rel:    "replaced/file.txt",
+			expErr: true,
+		},
+
+		"A file replaced with a symlink should not be followed.": {
+			rel:    "file-link",
+			expErr: true,
+		},
+
+		"A file created through a symlink should not be followed.": {
+			rel:    "replaced/new.txt",
+			flag:   os.O_WRONLY | os.O_CREATE,
+			expErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			f, err := pluginv1.OpenFileBeneath(root, filepath.FromSlash(test.rel), test.flag, 0o644)
+			if test.expErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			defer f.Close()
+
+			content, err := io.ReadAll(f)
+			require.NoError(err)
+			assert.Equal(test.expContent, string(content))
+		})
+	}
+
+	_, err := os.Stat(filepath.Join(dir, "outside", "new.txt"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestPathOperationsBeneath(t *testing.T) {
+	tests := map[string]struct {
+		op     func(root string) error
+		expErr bool
+	}{
+		"A directory should be created inside the root.": {
+			op: func(root string) error { return pluginv1.MkdirBeneath(root, filepath.Join("data", "new"), 0o755) },
+		},
+
+		"A directory should not be created through a replaced directory.": {
+			op:     func(root string) error { return pluginv1.MkdirBeneath(root, filepath.Join("replaced", "new"), 0o755) },
+			expErr: true,
+		},
+
+		"Directories should be created inside the root.": {
+			op: func(root string) error { return pluginv1.MkdirAllBeneath(root, filepath.Join("data", "a", "b"), 0o755) },
+		},
+
+		"Directories should not be created through a replaced directory.": {
+			op: func(root string) error {
+				return pluginv1.MkdirAllBeneath(root, filepath.Join("replaced", "a", "b"), 0o755)
+			},
+			expErr: true,
+		},
+
+		"A file should not be removed through a replaced directory.": {
+			op:     func(root string) error { return pluginv1.RemoveBeneath(root, filepath.Join("replaced", "file.txt")) },
+			expErr: true,
+		},
+
+		"A directory should not be removed through a replaced directory.": {
+			op:     func(root string) error { return pluginv1.RemoveAllBeneath(root, filepath.Join("replaced", "dir")) },
+			expErr: true,
+		},
+
+		"A replaced directory should be removed without removing its target.": {
+			op: func(root string) error { return pluginv1.RemoveAllBeneath(root, "replaced") },
+		},
+
+		"A file should not be renamed through a replaced directory.": {
+			op: func(root string) error {
+				return pluginv1.RenameBeneath(root, filepath.Join("replaced", "file.txt"), filepath.Join("data", "moved.txt"))
+			},
+			expErr: true,
+		},
+
+		"A file should not be renamed into a replaced directory.": {
+			op: func(root string) error {
+				return pluginv1.RenameBeneath(root, filepath.Join("data", "file.txt"), filepath.Join("replaced", "moved.txt"))
+			},
+			expErr: true,
+		},
+
+		"A file mode should not be changed through a replaced file.": {
+			op:     func(root string) error { return pluginv1.ChmodBeneath(root, "file-link", 0o600) },
+			expErr: true,
+		},
+
+		"A file mode should not be changed through a replaced directory.": {
+			op: func(root string) error {
+				return pluginv1.ChmodBeneath(root, filepath.Join("replaced", "file.txt"), 0o600)
+			},
+			expErr: true,
+		},
+
+		"A file owner should not be changed through a replaced directory.": {
+			op: func(root string) error {
+				return pluginv1.ChownBeneath(root, filepath.Join("replaced", "file.txt"), os.Getuid(), os.Getgid())
+			},
+			expErr: true,
+		},
+
+		"A file times should not be changed through a replaced directory.": {
+			op: func(root string) error {
+				return pluginv1.ChtimesBeneath(root, filepath.Join("replaced", "file.txt"), time.Time{}, time.Time{})
+			},
+			expErr: true,
+		},
+
+		"A file should not be truncated through a replaced file.": {
+			op:     func(root string) error { return pluginv1.TruncateBeneath(root, "file-link", 0) },
+			expErr: true,
+		},
+
+		"A file should not be stat through a replaced directory.": {
+			op: func(root string) error {
+				_, err := pluginv1.LstatBeneath(root, filepath.Join("replaced", "file.txt"))
+				return err
+			},
+			expErr: true,
+		},
+
+		"A symlink should not be read through a replaced directory.": {
+			op: func(root string) error {
+				_, err := pluginv1.ReadlinkBeneath(root, filepath.Join("replaced", "link"))
+				return err
+			},
+			expErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			// Prepare a root where a resolved directory and a file have been replaced with symlinks that escape the root.
+			dir := t.TempDir()
+			root := filepath.Join(dir, "root")
+			outside := filepath.Join(dir, "outside")
+			require.NoError(os.MkdirAll(filepath.Join(root, "data"), 0o755))
+			require.NoError(os.MkdirAll(filepath.Join(outside, "dir"), 0o755))
+			require.NoError(os.WriteFile(filepath.Join(root, "data", "file.txt"), []byte("inside"), 0o644))
+			require.NoError(os.WriteFile(filepath.Join(outside, "file.txt"), []byte("outside"), 0o644))
+			require.NoError(os.Symlink("file.txt", filepath.Join(outside, "link")))
+			require.NoError(os.Symlink(outside, filepath.Join(root, "replaced")))
+			require.NoError(os.Symlink(filepath.Join(outside, "file.txt"), filepath.Join(root, "file-link")))
+			outsideInfo, err := os.Stat(filepath.Join(outside, "file.txt"))
+			require.NoError(err)
+
+			err = test.op(root)
+			if test.expErr {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
+
+			// Nothing should have been changed outside the root.
+			entries, err := os.ReadDir(outside)
+			require.NoError(err)
+			names := []string{}
+			for _, e := range entries {
+				names = append(names, e.Name())
+			}
+			assert.Equal([]string{"dir", "file.txt", "link"}, names)
+			info, err := os.Stat(filepath.Join(outside, "file.txt"))
+			require.NoError(err)
+			assert.Equal(outsideInfo.Mode(), info.Mode())
+			assert.Equal(outsideInfo.Size(), info.Size())
+			assert.Equal(outsideInfo.ModTime(), info.ModTime())
+		})
+	}
+}
+
+func TestDataSourcePluginRead(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir   string
+		request     apiv1.ReadDataSourceRequest
+		expResponse *apiv1.ReadDataSourceResponse
+		expErr      bool
+	}{
+		"Noop plugin should end correctly being a NOOP.": {
+			pluginDir:   pluginDirNoop,
+			expResponse: &apiv1.ReadDataSourceResponse{},
+		},
+
+		"Error plugin should fail the execution.": {
+			pluginDir: pluginDirError,
+			expErr:    true,
+		},
+
+		"A correct plugin should return the correct result.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.ReadDataSourceRequest{
+				Attributes: "this is a test",
+			},
+			expResponse: &apiv1.ReadDataSourceResponse{
+				Result: "this is a testfrom_data_source",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
+			require.NoError(err)
+
+			// Create the plugin twice to check the plugin cache.
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewDataSourcePlugin",
+			}
+			engine := pluginv1.NewEngine()
+			_, err = engine.NewDataSourcePlugin(context.TODO(), config)
+			require.NoError(err)
+			p, err := engine.NewDataSourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			resp, err := p.ReadDataSource(context.TODO(), test.request)
+
+			if test.expErr {
+				assert.Error(err)
+			} else if assert.NoError(err) {
+				assert.Equal(test.expResponse, resp)
+			}
+		})
+	}
+}
+
+func TestDataSourcePluginValidate(t *testing.T) {
+	tests := map[string]struct {
+		pluginDir       string
+		request         apiv1.ValidateAttributesRequest
+		expNotValidator bool
+		expResponse     *apiv1.ValidateAttributesResponse
+		expErr          bool
+	}{
+		"Noop plugin should not implement the optional validator.": {
+			pluginDir:       pluginDirNoop,
+			expNotValidator: true,
+		},
+
+		"Error plugin should fail the execution.": {
+			pluginDir: pluginDirError,
+			expErr:    true,
+		},
+
+		"A correct plugin with valid attributes should not return diagnostics.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.ValidateAttributesRequest{
+				Attributes: "valid",
+			},
+			expResponse: &apiv1.ValidateAttributesResponse{},
+		},
+
+		"A correct plugin with invalid attributes should return diagnostics.": {
+			pluginDir: pluginDirOk,
+			request: apiv1.ValidateAttributesRequest{
+				Attributes: "invalid",
+			},
+			expResponse: &apiv1.ValidateAttributesResponse{
+				Diagnostics: []apiv1.Diagnostic{{Summary: "Invalid", Detail: "invalid attributes", AttributePath: "test1"}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(test.pluginDir))
+			require.NoError(err)
+
+			config := pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginOptions:        "",
+				PluginFactoryName:    "NewDataSourcePlugin",
+			}
+			p, err := pluginv1.NewEngine().NewDataSourcePlugin(context.TODO(), config)
+			require.NoError(err)
+
+			ok := pluginv1.Supports(p, pluginv1.CapabilityAttributesValidator)
+			if test.expNotValidator {
+				assert.False(ok)
+				return
+			}
+			require.True(ok)
+			validator := p.(apiv1.AttributesValidator)
+
+			resp, err := validator.ValidateAttributes(context.TODO(), test.request)
+
+			if test.expErr {
+				assert.Error(err)
+			} else if assert.NoError(err) {
+				assert.Equal(test.expResponse, resp)
+			}
+		})
+	}
+}
+
+func BenchmarkEngineLoadPlugins(b *testing.B) {
+	// Murmur3 dependency uses unsafe.
+	benchs := map[string]struct {
+		pluginDir string
+		plugins   int
+		sandbox   pluginv1.SandboxPolicy
+	}{
+		"murmur3 example with 1 plugin.":  {pluginDir: "../../../examples/with_dependencies/plugins/murmur3", plugins: 1, sandbox: pluginv1.SandboxPolicy{AllowUnsafe: true}},
+		"murmur3 example with 5 plugins.": {pluginDir: "../../../examples/with_dependencies/plugins/murmur3", plugins: 5, sandbox: pluginv1.SandboxPolicy{AllowUnsafe: true}},
+		"ulid example with 1 plugin.":     {pluginDir: "../../../examples/with_dependencies/plugins/ulid", plugins: 1},
+		"ulid example with 5 plugins.":    {pluginDir: "../../../examples/with_dependencies/plugins/ulid", plugins: 5},
+	}
+
+	for name, bench := range benchs {
+		b.Run(name, func(b *testing.B) {
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(bench.pluginDir))
+			require.NoError(b, err)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// Different options create different plugins from the same source code.
+				engine := pluginv1.NewEngine()
+				for j := 0; j < bench.plugins; j++ {
+					_, err := engine.NewDataSourcePlugin(context.TODO(), pluginv1.PluginConfig{
+						SourceCodeRepository: repo,
+						PluginOptions:        fmt.Sprintf(`{"instance": %d}`, j),
+						PluginFactoryName:    "NewDataSourcePlugin",
+						Sandbox:              bench.sandbox,
+					})
+					require.NoError(b, err)
+				}
+			}
+		})
+	}
+}
+
+func TestPluginProcess(t *testing.T) {
+	tests := map[string]struct {
+		id             string
+		limits         pluginv1.ProcessLimits
+		timeout        time.Duration
+		expErr         error
+		expErrContains string
+		expSameProcess bool
+	}{
+		"A plugin should be executed in the plugin process.": {
+			id:             "test",
+			expSameProcess: true,
+		},
+
+		"A plugin not found error should be kept.": {
+			id:             "notfound",
+			expErr:         apiv1.ErrNotFound,
+			expSameProcess: true,
+		},
+
+		"A plugin retryable error should be kept.": {
+			id:             "retryable",
+			expErr:         apiv1.ErrRetryable,
+			expSameProcess: true,
+		},
+
+		"A plugin panic should fail the execution without crashing the plugin process.": {
+			id:             "panic",
+			expErrContains: "plugin panicked: panic test",
+			expSameProcess: true,
+		},
+
+		"A plugin goroutine panic should crash the plugin process and restart it on the next operation.": {
+			id:             "goroutine-panic",
+			expErrContains: "plugin process crashed (exit status 2): panic: goroutine panic test",
+		},
+
+		"A plugin that exceeds the CPU time limit should crash the plugin process.": {
+			id:             "loop",
+			limits:         pluginv1.ProcessLimits{CPUTime: time.Second},
+			expErrContains: "plugin process crashed (exit status 2): plugin process: exceeded the CPU time limit (1s)",
+		},
+
+		"A plugin that exceeds the memory limit should crash the plugin process.": {
+			id:             "memory",
+			limits:         pluginv1.ProcessLimits{Memory: 64 * 1024 * 1024},
+			expErrContains: "plugin process crashed (exit status 2): plugin process: exceeded the memory limit (67108864 bytes)",
+		},
+
+		"A plugin that returns when the operation context is done should not kill the plugin process.": {
+			id:             "context",
+			timeout:        100 * time.Millisecond,
+			expErr:         context.DeadlineExceeded,
+			expSameProcess: true,
+		},
+
+		"A plugin that doesn't return when the operation context is done should kill the plugin process.": {
+			id:             "hang",
+			timeout:        100 * time.Millisecond,
+			expErr:         context.DeadlineExceeded,
+			expErrContains: "plugin process killed, the plugin didn't return after the operation ended: context deadline exceeded",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(*testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirProcess))
+			require.NoError(err)
+
+			engine := pluginv1.NewEngine()
+			defer engine.Close(context.TODO())
+			p, err := engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginFactoryName:    "NewResourcePlugin",
+				Execution:            pluginv1.ExecutionModeProcess,
+				ProcessLimits:        test.limits,
+			})
+			require.NoError(err)
+
+			readPID := func() string {
+				resp, err := p.ReadResource(context.TODO(), apiv1.ReadResourceRequest{ID: "pid"})
+				require.NoError(err)
+				return resp.Attributes
+			}
+			pid := readPID()
+			assert.NotEqual(fmt.Sprint(os.Getpid()), pid)
+
+			ctx := context.TODO()
+			if test.timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+			resp, err := p.ReadResource(ctx, apiv1.ReadResourceRequest{ID: test.id})
+
+			if test.expErr != nil || test.expErrContains != "" {
+				if assert.Error(err) {
+					if test.expErr != nil {
+						assert.ErrorIs(err, test.expErr)
+					}
+					if test.expErrContains != "" {
+						assert.Contains(err.Error(), test.expErrContains)
+					}
+				}
+			} else if assert.NoError(err) {
+				assert.Equal(test.id, resp.Attributes)
+			}
+
+			// The plugin process is restarted (and the plugin loaded again) after a crash.
+			if test.expSameProcess {
+				assert.Equal(pid, readPID())
+			} else {
+				assert.NotEqual(pid, readPID())
+			}
+		})
+	}
+}
+
+func TestPluginProcessHungRestart(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	repo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirProcess))
+	require.NoError(err)
+
+	var stdout testWriter
+	engine := pluginv1.NewEngine()
+	defer engine.Close(context.TODO())
+	p, err := engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+		SourceCodeRepository: repo,
+		PluginOptions:        "print-load",
+		PluginFactoryName:    "NewResourcePlugin",
+		Stdout:               &stdout,
+		Execution:            pluginv1.ExecutionModeProcess,
+	})
+	require.NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+	defer cancel()
+	_, err = p.ReadResource(ctx, apiv1.ReadResourceRequest{ID: "hang"})
+	assert.ErrorIs(err, context.DeadlineExceeded)
+
+	// The killed plugin process should be restarted loading the plugin again without waiting for the next operation.
+	assert.Eventually(func() bool {
+		return stdout.String() == "loaded\nloaded\n"
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestPluginProcessHungConcurrent(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	repo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirProcess))
+	require.NoError(err)
+
+	engine := pluginv1.NewEngine()
+	defer engine.Close(context.TODO())
+	p, err := engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+		SourceCodeRepository: repo,
+		PluginFactoryName:    "NewResourcePlugin",
+		Execution:            pluginv1.ExecutionModeProcess,
+	})
+	require.NoError(err)
+
+	// Run an operation in the plugin process while another one hangs.
+	slowErr := make(chan error, 1)
+	go func() {
+		_, err := p.ReadResource(context.TODO(), apiv1.ReadResourceRequest{ID: "slow"})
+		slowErr <- err
+	}()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+	defer cancel()
+	_, err = p.ReadResource(ctx, apiv1.ReadResourceRequest{ID: "hang"})
+	assert.ErrorContains(err, "plugin process killed, the plugin didn't return after the operation ended")
+	assert.NotErrorIs(err, apiv1.ErrRetryable)
+
+	// The operation killed with the hung one should fail with a retryable error.
+	err = <-slowErr
+	assert.ErrorIs(err, apiv1.ErrRetryable)
+	assert.ErrorContains(err, "plugin process killed, another plugin operation didn't return after it ended")
+
+	// The plugin should work on the restarted plugin process.
+	resp, err := p.ReadResource(context.TODO(), apiv1.ReadResourceRequest{ID: "ok"})
+	require.NoError(err)
+	assert.Equal("ok", resp.Attributes)
+}
+
+func TestPluginProcessEnv(t *testing.T) {
+	t.Setenv("TEST_GOPLUGIN_PROCESS_HOST", "host")
+
+	tests := map[string]struct {
+		env       []string
+		expResult string
+	}{
+		"The plugin process should only have the plugin environment.": {
+			env:       []string{"TEST_GOPLUGIN_PROCESS_PLUGIN=plugin"},
+			expResult: "-plugin",
+		},
+
+		"The plugin process should inherit the engine environment if the plugin doesn't have environment.": {
+			expResult: "host-",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			repo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirProcess))
+			require.NoError(err)
+
+			engine := pluginv1.NewEngine()
+			defer engine.Close(context.TODO())
+			p, err := engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+				SourceCodeRepository: repo,
+				PluginFactoryName:    "NewResourcePlugin",
+				Env:                  test.env,
+				Execution:            pluginv1.ExecutionModeProcess,
+			})
+			require.NoError(err)
+
+			resp, err := p.ReadResource(context.TODO(), apiv1.ReadResourceRequest{ID: "env"})
+			require.NoError(err)
+			assert.Equal(test.expResult, resp.Attributes)
+		})
+	}
+}
+
+func TestPluginProcessPlugin(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	repo, err := moduledir.NewSourceCodeRepository(os.DirFS(pluginDirProcess))
+	require.NoError(err)
+
+	var stdout, stderr testWriter
+	engine := pluginv1.NewEngine()
+	defer engine.Close(context.TODO())
+	p, err := engine.NewResourcePlugin(context.TODO(), pluginv1.PluginConfig{
+		SourceCodeRepository: repo,
+		PluginFactoryName:    "NewResourcePlugin",
+		Stdout:               &stdout,
+		Stderr:               &stderr,
+		Execution:            pluginv1.ExecutionModeProcess,
+	})
+	require.NoError(err)
+
+	// Requests and responses should cross the plugin process.
+	createResp, err := p.CreateResource(context.TODO(), apiv1.CreateResourceRequest{Attributes: "test"})
+	require.NoError(err)
+	assert.Equal(&apiv1.CreateResourceResponse{ID: "test_id"}, createResp)
+
+	// The capabilities should be kept.
+	if assert.True(pluginv1.Supports(p, pluginv1.CapabilityHealthChecker)) {
+		resp, err := p.(apiv1.HealthChecker).HealthCheck(context.TODO(), apiv1.HealthCheckRequest{})
+		if assert.NoError(err) {
+			assert.Equal(&apiv1.HealthCheckResponse{Diagnostics: []apiv1.Diagnostic{{Summary: "healthy"}}}, resp)
+		}
+	}
+	if assert.True(pluginv1.Supports(p, pluginv1.CapabilityInfoProvider)) {
+		assert.Equal(apiv1.PluginInfo{Name: "process", Version: "v1.0.0"}, p.(apiv1.InfoProvider).Info())
+	}
+	assert.False(pluginv1.Supports(p, pluginv1.CapabilityResourcePlanner))
+
+	// The request information should be sent to the plugin process.
+	ctx := apiv1.ContextWithRequestInfo(context.TODO(), apiv1.RequestInfo{PluginID: "test", Operation: "read"})
+	readResp, err := p.ReadResource(ctx, apiv1.ReadResourceRequest{ID: "request-info"})
+	require.NoError(err)
+	assert.Equal("test,read", readResp.Attributes)
+
+	// The output and the logs should be sent from the plugin process.
+	gotMessages := []string{}
+	ctx = apiv1.ContextWithLogger(context.TODO(), testLogger{messages: &gotMessages})
+	_, err = p.ReadResource(ctx, apiv1.ReadResourceRequest{ID: "output"})
+	require.NoError(err)
+	assert.Equal([]string{"[info] log test 42 map[k:v]"}, gotMessages)
+	assert.Eventually(func() bool {
+		return stdout.String() == "stdout test\n" && stderr.String() == "stderr test\n"
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal("stdout test\n", stdout.String())
+	assert.Equal("stderr test\n", stderr.String())
+}
@@ -0,0 +1,735 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+// ExecutionMode is where the engine executes the plugins.
+type ExecutionMode string
+
+const (
+	// ExecutionModeInProcess executes the plugins inside the engine process.
+	ExecutionModeInProcess ExecutionMode = "in_process"
+	// ExecutionModeProcess executes the plugins in a child process of the engine process, isolating the engine
+	// from the plugin crashes (e.g: panics in goroutines), hung plugins and resource usage.
+	// The engine process binary must serve the plugin process (check `ServePluginProcess`).
+	ExecutionModeProcess ExecutionMode = "process"
+)
+
+// ProcessLimits are the resource limits of a plugin process, the plugin process is killed when a limit is
+// exceeded and restarted on the next plugin operation.
+type ProcessLimits struct {
+	// CPUTime is the CPU time that the plugin process can use during its lifetime, unlimited if zero.
+	// Only supported on Linux, macOS and FreeBSD.
+	CPUTime time.Duration
+	// Memory is the memory in bytes that the plugin process can use, unlimited if zero.
+	Memory uint64
+}
+
+func (p ProcessLimits) index() string {
+	return fmt.Sprintf("%s%d", p.CPUTime, p.Memory)
+}
+
+const (
+	// pluginProcessCancelGracePeriod is the time that a plugin has to return after its operation context is done,
+	// after it, the plugin process is killed.
+	pluginProcessCancelGracePeriod = 2 * time.Second
+	// pluginProcessStopTimeout is the time that a plugin process has to stop gracefully before being killed.
+	pluginProcessStopTimeout = 5 * time.Second
+	// pluginProcessRestartTimeout is the time that a killed plugin process has to start and load its plugins again.
+	pluginProcessRestartTimeout = time.Minute
+	// pluginProcessRPCService is the name of the plugin process RPC service.
+	pluginProcessRPCService = "PluginProcess"
+)
+
+// Plugin process protocol kinds.
+const (
+	pluginProcessKindResource   = "resource"
+	pluginProcessKindDataSource = "data_source"
+	pluginProcessKindModule     = "module"
+)
+
+// PluginProcessLoadArgs are the plugin process protocol arguments to load a plugin (or plugin module).
+type PluginProcessLoadArgs struct {
+	Handle        string
+	Kind          string
+	Files         map[string][]byte
+	Index         string
+	Gopath        string
+	ImportPath    string
+	PluginOptions string
+	FactoryName   string
+	Sandbox       SandboxPolicy
+	Env           []string
+	Network       NetworkPolicy
+	Filesystem    FilesystemPolicy
+}
+
+// PluginProcessLoadReply is the plugin process protocol reply with the loaded plugins.
+type PluginProcessLoadReply struct {
+	Plugins []PluginProcessPlugin
+}
+
+// PluginProcessPlugin is a plugin loaded in a plugin process.
+type PluginProcessPlugin struct {
+	Handle string
+	Kind   string
+	// Name is the registry name of the plugin module plugins.
+	Name string
+	// Capabilities are the optional `apiv1` interfaces that the plugin implements.
+	Capabilities Capability
+	// Statics are the JSON results of the supported plugin methods without arguments (e.g: `Info`).
+	Statics map[string]json.RawMessage
+}
+
+// PluginProcessCallArgs are the plugin process protocol arguments to call a plugin method.
+type PluginProcessCallArgs struct {
+	CallID      uint64
+	Handle      string
+	Method      string
+	Request     json.RawMessage
+	Deadline    time.Time
+	RequestInfo apiv1.RequestInfo
+}
+
+// PluginProcessCancelArgs are the plugin process protocol arguments to cancel a plugin method call.
+type PluginProcessCancelArgs struct {
+	CallID uint64
+	// DeadlineExceeded is set when the call deadline has been exceeded, the plugin method context has the same
+	// deadline, so the plugin gets the deadline error instead of a cancellation.
+	DeadlineExceeded bool
+}
+
+// PluginProcessCallReply is the plugin process protocol reply of a plugin method call.
+type PluginProcessCallReply struct {
+	Response json.RawMessage
+	Err      *PluginProcessError
+}
+
+// PluginProcessError is an error returned by a plugin in a plugin process.
+type PluginProcessError struct {
+	Message          string
+	NotFound         bool
+	Retryable        bool
+	Canceled         bool
+	DeadlineExceeded bool
+}
+
+func newPluginProcessError(err error) *PluginProcessError {
+	return &PluginProcessError{
+		Message:          err.Error(),
+		NotFound:         errors.Is(err, apiv1.ErrNotFound),
+		Retryable:        errors.Is(err, apiv1.ErrRetryable),
+		Canceled:         errors.Is(err, context.Canceled),
+		DeadlineExceeded: errors.Is(err, context.DeadlineExceeded),
+	}
+}
+
+func (p *PluginProcessError) Error() string { return p.Message }
+
+// Is keeps the `apiv1` and context errors of the plugin when crossing the plugin process boundary.
+func (p *PluginProcessError) Is(target error) bool {
+	switch target {
+	case apiv1.ErrNotFound:
+		return p.NotFound
+	case apiv1.ErrRetryable:
+		return p.Retryable
+	case context.Canceled:
+		return p.Canceled
+	case context.DeadlineExceeded:
+		return p.DeadlineExceeded
+	}
+	return false
+}
+
+// errPluginProcessHungKill is the error of the plugin operations that were running in a plugin process killed
+// because of another plugin operation that didn't return, these can be retried on the restarted plugin process.
+var errPluginProcessHungKill = apiv1.Retryable(errors.New("plugin process killed, another plugin operation didn't return after it ended"))
+
+// pluginProcess is the child process where the plugins of the same interpreter are executed. The process is
+// started on the first use, and restarted (loading again its plugins) on the next use after a crash.
+type pluginProcess struct {
+	config PluginConfig
+
+	mu       sync.Mutex
+	instance *pluginProcessInstance
+	loads    []PluginProcessLoadArgs
+	stopped  bool
+
+	callID   uint64
+	loggers  sync.Map
+	callEnds sync.Map
+}
+
+// pluginProcessInstance is a running plugin process.
+type pluginProcessInstance struct {
+	cmd       *exec.Cmd
+	client    *rpc.Client
+	exited    chan struct{}
+	exitState string
+	// crashReason is the last fatal message (e.g: a panic) written by the plugin process.
+	crashReason atomic.Value
+	// hungKill is set when the plugin process is killed because of a plugin operation that didn't return.
+	hungKill atomic.Bool
+}
+
+func (i *pluginProcessInstance) isExited() bool {
+	select {
+	case <-i.exited:
+		return true
+	default:
+		return false
+	}
+}
+
+func (i *pluginProcessInstance) kill() {
+	_ = i.cmd.Process.Kill()
+	<-i.exited
+}
+
+// callError returns the error of a plugin operation that lost its connection with the plugin process.
+func (i *pluginProcessInstance) callError() error {
+	if i.hungKill.Load() {
+		<-i.exited
+		return errPluginProcessHungKill
+	}
+
+	return i.crashError()
+}
+
+// crashError returns the error of a plugin process that exited unexpectedly.
+func (i *pluginProcessInstance) crashError() error {
+	// Wait for the process exit state, the connection can be closed before the process exits.
+	select {
+	case <-i.exited:
+	case <-time.After(pluginProcessStopTimeout):
+		i.kill()
+	}
+
+	if reason, _ := i.crashReason.Load().(string); reason != "" {
+		return fmt.Errorf("plugin process crashed (%s): %s", i.exitState, reason)
+	}
+
+	return fmt.Errorf("plugin process crashed (%s)", i.exitState)
+}
+
+// pluginProcess returns the plugin process of the plugin, the plugin processes are shared by the plugins that
+// would share the same interpreter.
+func (e *Engine) pluginProcess(ctx context.Context, config PluginConfig) *pluginProcess {
+	p, _ := e.processesCache.LoadOrStore(interpreterIndex(ctx, config), &pluginProcess{config: config})
+	// Should always be a plugin process, we control the type internally,
+	// panicking its ok, shouldn't happen.
+	return p.(*pluginProcess)
+}
+
+// newPluginProcessLoadArgs returns the plugin process load arguments of a plugin, the source code files are sent to the
+// plugin process.
+func newPluginProcessLoadArgs(ctx context.Context, config PluginConfig, handle, kind string) (PluginProcessLoadArgs, error) {
+	repo := config.SourceCodeRepository
+	files := map[string][]byte{}
+	err := fs.WalkDir(repo.FS(ctx), ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		data, err := fs.ReadFile(repo.FS(ctx), path)
+		if err != nil {
+			return fmt.Errorf("could not read %q file: %w", path, err)
+		}
+		files[path] = data
+
+		return nil
+	})
+	if err != nil {
+		return PluginProcessLoadArgs{}, fmt.Errorf("could not read source code: %w", err)
+	}
+
+	// The plugins without environment inherit the engine environment, not the plugin process one.
+	env := config.Env
+	if env == nil {
+		env = os.Environ()
+	}
+
+	return PluginProcessLoadArgs{
+		Handle:        handle,
+		Kind:          kind,
+		Files:         files,
+		Index:         repo.Index(ctx),
+		Gopath:        repo.Gopath(ctx),
+		ImportPath:    repo.ImportPath(ctx),
+		PluginOptions: config.PluginOptions,
+		FactoryName:   config.PluginFactoryName,
+		Sandbox:       config.Sandbox,
+		Env:           env,
+		Network:       config.Network,
+		Filesystem:    config.Filesystem,
+	}, nil
+}
+
+// load loads a plugin (or plugin module) in the plugin process, the loaded plugins will be loaded again
+// if the plugin process is restarted.
+func (p *pluginProcess) load(ctx context.Context, args PluginProcessLoadArgs) ([]PluginProcessPlugin, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	instance, err := p.runningInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plugins, err := instance.load(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	p.loads = append(p.loads, args)
+
+	return plugins, nil
+}
+
+func (i *pluginProcessInstance) load(ctx context.Context, args PluginProcessLoadArgs) ([]PluginProcessPlugin, error) {
+	var reply PluginProcessLoadReply
+	call := i.client.Go(pluginProcessRPCService+".Load", args, &reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+	case <-ctx.Done():
+		i.kill()
+		return nil, fmt.Errorf("plugin process killed: %w", ctx.Err())
+	}
+
+	if call.Error != nil {
+		if errors.As(call.Error, new(rpc.ServerError)) {
+			return nil, call.Error
+		}
+		return nil, i.crashError()
+	}
+
+	return reply.Plugins, nil
+}
+
+// runningInstance returns the running plugin process, starting a new one if required. Must be called
+// with the lock acquired.
+func (p *pluginProcess) runningInstance(ctx context.Context) (*pluginProcessInstance, error) {
+	if p.stopped {
+		return nil, fmt.Errorf("plugin process is stopped")
+	}
+
+	if p.instance != nil && !p.instance.isExited() {
+		return p.instance, nil
+	}
+
+	instance, err := p.start()
+	if err != nil {
+		return nil, fmt.Errorf("could not start plugin process: %w", err)
+	}
+
+	// Load again the plugins of the crashed plugin process.
+	for _, args := range p.loads {
+		_, err := instance.load(ctx, args)
+		if err != nil {
+			instance.kill()
+			return nil, fmt.Errorf("could not load plugins on restarted plugin process: %w", err)
+		}
+	}
+	p.instance = instance
+
+	return instance, nil
+}
+
+// start executes the engine process binary as a plugin process.
+func (p *pluginProcess) start() (*pluginProcessInstance, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("could not get executable: %w", err)
+	}
+
+	// The plugin process only has the plugin environment, so the processes started by the plugin (e.g: `os/exec`)
+	// don't inherit the engine environment.
+	env := p.config.Env
+	if env == nil {
+		env = os.Environ()
+	}
+	cmd := exec.Command(executable)
+	cmd.Env = append(append([]string{}, env...), pluginProcessEnvName+"="+pluginProcessEnvValue)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	instance := &pluginProcessInstance{
+		cmd:    cmd,
+		client: jsonrpc.NewClient(pluginProcessConn{Reader: stdout, WriteCloser: stdin}),
+		exited: make(chan struct{}),
+	}
+
+	// The plugin process stderr has the plugins output, logs and the plugin process fatal messages.
+	stderrDone := make(chan struct{})
+	go func() {
+		defer close(stderrDone)
+		p.handleOutput(instance, stderr)
+	}()
+
+	go func() {
+		<-stderrDone
+		_ = cmd.Wait()
+		instance.exitState = cmd.ProcessState.String()
+		_ = instance.client.Close()
+		close(instance.exited)
+	}()
+
+	var reply struct{}
+	err = instance.client.Call(pluginProcessRPCService+".Init", p.config.ProcessLimits, &reply)
+	if err != nil {
+		if errors.As(err, new(rpc.ServerError)) {
+			instance.kill()
+			return nil, err
+		}
+		return nil, instance.crashError()
+	}
+
+	return instance, nil
+}
+
+// handleOutput dispatches the plugin process output lines.
+func (p *pluginProcess) handleOutput(instance *pluginProcessInstance, r io.Reader) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		kind, data, _ := strings.Cut(line, " ")
+		switch kind {
+		case pluginProcessOutputStdout:
+			fmt.Fprintln(p.config.Stdout, data)
+		case pluginProcessOutputStderr:
+			fmt.Fprintln(p.config.Stderr, data)
+		case pluginProcessOutputLog:
+			p.handleLog(data)
+		case pluginProcessOutputEnd:
+			if end, ok := p.callEnds.LoadAndDelete(data); ok {
+				close(end.(chan struct{}))
+			}
+		default:
+			// Plugin process own messages (e.g: Go runtime panics).
+			if strings.HasPrefix(line, "panic: ") || strings.HasPrefix(line, "fatal error: ") || strings.HasPrefix(line, pluginProcessFatalPrefix) {
+				instance.crashReason.Store(line)
+			}
+			fmt.Fprintln(p.config.Stderr, line)
+		}
+	}
+}
+
+// handleLog logs a plugin log message with the logger of the plugin operation.
+func (p *pluginProcess) handleLog(data string) {
+	var msg pluginProcessLogMessage
+	if err := json.Unmarshal([]byte(data), &msg); err != nil {
+		return
+	}
+
+	l, ok := p.loggers.Load(msg.CallID)
+	if !ok {
+		return
+	}
+	logger := l.(apiv1.Logger)
+	if len(msg.Values) > 0 {
+		logger = logger.WithValues(msg.Values)
+	}
+
+	switch msg.Level {
+	case pluginProcessLogLevelDebug:
+		logger.Debugf("%s", msg.Message)
+	case pluginProcessLogLevelInfo:
+		logger.Infof("%s", msg.Message)
+	case pluginProcessLogLevelWarning:
+		logger.Warningf("%s", msg.Message)
+	default:
+		logger.Errorf("%s", msg.Message)
+	}
+}
+
+// call calls a plugin method in the plugin process. If the plugin doesn't return after the context is done
+// the plugin process is killed and restarted, so the plugin operation doesn't keep running (the other operations
+// running in the plugin process fail with a retryable error).
+func (p *pluginProcess) call(ctx context.Context, args PluginProcessCallArgs) (json.RawMessage, error) {
+	p.mu.Lock()
+	instance, err := p.runningInstance(ctx)
+	p.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	args.CallID = atomic.AddUint64(&p.callID, 1)
+	args.RequestInfo = apiv1.RequestInfoFromContext(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		args.Deadline = deadline
+	}
+	p.loggers.Store(args.CallID, apiv1.LoggerFromContext(ctx))
+	defer p.loggers.Delete(args.CallID)
+	end := make(chan struct{})
+	p.callEnds.Store(fmt.Sprint(args.CallID), end)
+	defer p.callEnds.Delete(fmt.Sprint(args.CallID))
+
+	var reply PluginProcessCallReply
+	call := instance.client.Go(pluginProcessRPCService+".Call", args, &reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+	case <-ctx.Done():
+		// Give the plugin some time to return after the cancellation.
+		cancelArgs := PluginProcessCancelArgs{CallID: args.CallID, DeadlineExceeded: errors.Is(ctx.Err(), context.DeadlineExceeded)}
+		instance.client.Go(pluginProcessRPCService+".Cancel", cancelArgs, new(struct{}), make(chan *rpc.Call, 1))
+		select {
+		case <-call.Done:
+		case <-time.After(pluginProcessCancelGracePeriod):
+			// The other plugin operations running in the plugin process will fail with a retryable error.
+			instance.hungKill.Store(true)
+			instance.kill()
+			go p.restart(instance)
+			return nil, fmt.Errorf("plugin process killed, the plugin didn't return after the operation ended: %w", ctx.Err())
+		}
+	}
+
+	if call.Error != nil {
+		if errors.As(call.Error, new(rpc.ServerError)) {
+			return nil, call.Error
+		}
+		return nil, instance.callError()
+	}
+
+	// Wait until all the output of the call (e.g: logs) has been handled, the output and the reply are not
+	// received in order.
+	select {
+	case <-end:
+	case <-instance.exited:
+	}
+
+	if reply.Err != nil {
+		return nil, reply.Err
+	}
+
+	return reply.Response, nil
+}
+
+// restart starts again a killed plugin process loading its plugins, so the next operations don't have to wait for
+// it. If it fails, the next operation will start it.
+func (p *pluginProcess) restart(killed *pluginProcessInstance) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.instance != killed {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pluginProcessRestartTimeout)
+	defer cancel()
+	_, _ = p.runningInstance(ctx)
+}
+
+// stop stops the plugin process gracefully, killing it if it doesn't stop in time.
+func (p *pluginProcess) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stopped = true
+	if p.instance == nil {
+		return
+	}
+
+	// Closing the connection stops the plugin process.
+	_ = p.instance.client.Close()
+	select {
+	case <-p.instance.exited:
+	case <-time.After(pluginProcessStopTimeout):
+		p.instance.kill()
+	}
+}
+
+type pluginProcessConn struct {
+	io.Reader
+	io.WriteCloser
+}
+
+// pluginProcessClient is a plugin loaded in a plugin process, it calls the plugin methods in the plugin process.
+// It has all the plugin methods, the engine wraps it with the capabilities of the loaded plugin.
+type pluginProcessClient struct {
+	process *pluginProcess
+	plugin  PluginProcessPlugin
+}
+
+// pluginProcessCall calls a plugin method with a request and a response in the plugin process.
+func pluginProcessCall[Response any](ctx context.Context, c pluginProcessClient, method string, request interface{}) (*Response, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal request: %w", err)
+	}
+
+	response, err := c.process.call(ctx, PluginProcessCallArgs{Handle: c.plugin.Handle, Method: method, Request: data})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *Response
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal response: %w", err)
+	}
+
+	return resp, nil
+}
+
+// static returns the result of a plugin method without arguments, known when the plugin is loaded.
+func (c pluginProcessClient) static(method string, v interface{}) {
+	if data, ok := c.plugin.Statics[method]; ok {
+		_ = json.Unmarshal(data, v)
+	}
+}
+
+func (c pluginProcessClient) CreateResource(ctx context.Context, r apiv1.CreateResourceRequest) (*apiv1.CreateResourceResponse, error) {
+	return pluginProcessCall[apiv1.CreateResourceResponse](ctx, c, "CreateResource", r)
+}
+
+func (c pluginProcessClient) ReadResource(ctx context.Context, r apiv1.ReadResourceRequest) (*apiv1.ReadResourceResponse, error) {
+	return pluginProcessCall[apiv1.ReadResourceResponse](ctx, c, "ReadResource", r)
+}
+
+func (c pluginProcessClient) UpdateResource(ctx context.Context, r apiv1.UpdateResourceRequest) (*apiv1.UpdateResourceResponse, error) {
+	return pluginProcessCall[apiv1.UpdateResourceResponse](ctx, c, "UpdateResource", r)
+}
+
+func (c pluginProcessClient) DeleteResource(ctx context.Context, r apiv1.DeleteResourceRequest) (*apiv1.DeleteResourceResponse, error) {
+	return pluginProcessCall[apiv1.DeleteResourceResponse](ctx, c, "DeleteResource", r)
+}
+
+func (c pluginProcessClient) ReadDataSource(ctx context.Context, r apiv1.ReadDataSourceRequest) (*apiv1.ReadDataSourceResponse, error) {
+	return pluginProcessCall[apiv1.ReadDataSourceResponse](ctx, c, "ReadDataSource", r)
+}
+
+func (c pluginProcessClient) PlanResource(ctx context.Context, r apiv1.PlanResourceRequest) (*apiv1.PlanResourceResponse, error) {
+	return pluginProcessCall[apiv1.PlanResourceResponse](ctx, c, "PlanResource", r)
+}
+
+func (c pluginProcessClient) ValidateAttributes(ctx context.Context, r apiv1.ValidateAttributesRequest) (*apiv1.ValidateAttributesResponse, error) {
+	return pluginProcessCall[apiv1.ValidateAttributesResponse](ctx, c, "ValidateAttributes", r)
+}
+
+func (c pluginProcessClient) ImportResource(ctx context.Context, r apiv1.ImportResourceRequest) (*apiv1.ImportResourceResponse, error) {
+	return pluginProcessCall[apiv1.ImportResourceResponse](ctx, c, "ImportResource", r)
+}
+
+func (c pluginProcessClient) AttributesVersion() int {
+	var version int
+	c.static("AttributesVersion", &version)
+	return version
+}
+
+func (c pluginProcessClient) UpgradeAttributes(ctx context.Context, r apiv1.UpgradeAttributesRequest) (*apiv1.UpgradeAttributesResponse, error) {
+	return pluginProcessCall[apiv1.UpgradeAttributesResponse](ctx, c, "UpgradeAttributes", r)
+}
+
+func (c pluginProcessClient) AttributesEqual(ctx context.Context, r apiv1.AttributesEqualRequest) (*apiv1.AttributesEqualResponse, error) {
+	return pluginProcessCall[apiv1.AttributesEqualResponse](ctx, c, "AttributesEqual", r)
+}
+
+func (c pluginProcessClient) HealthCheck(ctx context.Context, r apiv1.HealthCheckRequest) (*apiv1.HealthCheckResponse, error) {
+	return pluginProcessCall[apiv1.HealthCheckResponse](ctx, c, "HealthCheck", r)
+}
+
+func (c pluginProcessClient) Close(ctx context.Context) error {
+	_, err := c.process.call(ctx, PluginProcessCallArgs{Handle: c.plugin.Handle, Method: "Close"})
+	return err
+}
+
+func (c pluginProcessClient) Info() apiv1.PluginInfo {
+	var info apiv1.PluginInfo
+	c.static("Info", &info)
+	return info
+}
+
+func (e *Engine) newProcessResourcePlugin(ctx context.Context, config PluginConfig) (apiv1.ResourcePlugin, error) {
+	process := e.pluginProcess(ctx, config)
+	args, err := newPluginProcessLoadArgs(ctx, config, pluginProcessKindResource+"/"+pluginIndex(ctx, config), pluginProcessKindResource)
+	if err != nil {
+		return nil, err
+	}
+
+	plugins, err := process.load(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	client := pluginProcessClient{process: process, plugin: plugins[0]}
+
+	return newResourcePlugin(client, plugins[0].Capabilities), nil
+}
+
+func (e *Engine) newProcessDataSourcePlugin(ctx context.Context, config PluginConfig) (apiv1.DataSourcePlugin, error) {
+	process := e.pluginProcess(ctx, config)
+	args, err := newPluginProcessLoadArgs(ctx, config, pluginProcessKindDataSource+"/"+pluginIndex(ctx, config), pluginProcessKindDataSource)
+	if err != nil {
+		return nil, err
+	}
+
+	plugins, err := process.load(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	client := pluginProcessClient{process: process, plugin: plugins[0]}
+
+	return newDataSourcePlugin(client, plugins[0].Capabilities), nil
+}
+
+func (e *Engine) newProcessPluginModule(ctx context.Context, config PluginModuleConfig) (*PluginModule, error) {
+	pluginConfig := config.pluginConfig()
+	process := e.pluginProcess(ctx, pluginConfig)
+	args, err := newPluginProcessLoadArgs(ctx, pluginConfig, pluginProcessKindModule+"/"+pluginIndex(ctx, pluginConfig), pluginProcessKindModule)
+	if err != nil {
+		return nil, err
+	}
+
+	plugins, err := process.load(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	module := &PluginModule{
+		ResourcePlugins:   map[string]apiv1.ResourcePlugin{},
+		DataSourcePlugins: map[string]apiv1.DataSourcePlugin{},
+	}
+	for _, p := range plugins {
+		switch p.Kind {
+		case pluginProcessKindResource:
+			module.ResourcePlugins[p.Name] = newResourcePlugin(pluginProcessClient{process: process, plugin: p}, p.Capabilities)
+		case pluginProcessKindDataSource:
+			module.DataSourcePlugins[p.Name] = newDataSourcePlugin(pluginProcessClient{process: process, plugin: p}, p.Capabilities)
+		}
+	}
+
+	return module, nil
+}
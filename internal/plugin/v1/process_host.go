@@ -0,0 +1,396 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"testing/fstest"
+	"time"
+
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+const (
+	pluginProcessEnvName  = "GOPLUGIN_PLUGIN_PROCESS"
+	pluginProcessEnvValue = "v1"
+
+	// Plugin process output line kinds, the lines without kind are the plugin process own messages.
+	pluginProcessOutputStdout = "stdout"
+	pluginProcessOutputStderr = "stderr"
+	pluginProcessOutputLog    = "log"
+	pluginProcessOutputEnd    = "end"
+
+	// pluginProcessFatalPrefix is the prefix of the plugin process messages that explain why it stopped.
+	pluginProcessFatalPrefix = "plugin process: "
+
+	pluginProcessMemoryCheckInterval = 100 * time.Millisecond
+)
+
+// IsPluginProcess returns true if the binary has been executed by the engine as a plugin process.
+func IsPluginProcess() bool {
+	return os.Getenv(pluginProcessEnvName) == pluginProcessEnvValue
+}
+
+// ServePluginProcess serves the plugins of the engine using the process execution mode, the plugin process
+// communicates with the engine using the standard input and output, and it stops when the engine closes them.
+// The binaries using the engine process execution mode must call it at the beginning when `IsPluginProcess`
+// is true (e.g: the provider `main`).
+func ServePluginProcess(ctx context.Context) error {
+	output := &pluginProcessOutput{w: os.Stderr}
+	server := &pluginProcessServer{
+		engine: NewEngine(),
+		output: output,
+		stdout: &pluginProcessLineWriter{kind: pluginProcessOutputStdout, output: output},
+		stderr: &pluginProcessLineWriter{kind: pluginProcessOutputStderr, output: output},
+	}
+
+	rpcServer := rpc.NewServer()
+	err := rpcServer.RegisterName(pluginProcessRPCService, server)
+	if err != nil {
+		return fmt.Errorf("could not register plugin process service: %w", err)
+	}
+	rpcServer.ServeCodec(jsonrpc.NewServerCodec(pluginProcessConn{Reader: os.Stdin, WriteCloser: os.Stdout}))
+
+	// The engine closed the connection, close the plugins.
+	ctx, cancel := context.WithTimeout(ctx, pluginProcessStopTimeout)
+	defer cancel()
+
+	return server.engine.Close(ctx)
+}
+
+// pluginProcessServer is the plugin process RPC service.
+type pluginProcessServer struct {
+	engine  *Engine
+	output  *pluginProcessOutput
+	stdout  io.Writer
+	stderr  io.Writer
+	plugins sync.Map
+	cancels sync.Map
+}
+
+// Init sets the limits of the plugin process.
+func (s *pluginProcessServer) Init(limits ProcessLimits, _ *struct{}) error {
+	if limits.CPUTime > 0 {
+		err := setPluginProcessCPUTimeLimit(limits.CPUTime, func() {
+			s.fatalf("exceeded the CPU time limit (%s)", limits.CPUTime)
+		})
+		if err != nil {
+			return fmt.Errorf("could not set CPU time limit: %w", err)
+		}
+	}
+
+	if limits.Memory > 0 {
+		debug.SetMemoryLimit(int64(limits.Memory))
+		go func() {
+			var stats runtime.MemStats
+			for range time.Tick(pluginProcessMemoryCheckInterval) {
+				runtime.ReadMemStats(&stats)
+				if stats.Sys-stats.HeapReleased > limits.Memory {
+					s.fatalf("exceeded the memory limit (%d bytes)", limits.Memory)
+				}
+			}
+		}()
+	}
+
+	return nil
+}
+
+// fatalf stops the plugin process explaining the reason to the engine.
+func (s *pluginProcessServer) fatalf(format string, args ...interface{}) {
+	s.output.writeLine(pluginProcessFatalPrefix + fmt.Sprintf(format, args...))
+	os.Exit(2)
+}
+
+// Load loads a plugin (or the plugins of a plugin module) and registers them with their handles.
+func (s *pluginProcessServer) Load(args PluginProcessLoadArgs, reply *PluginProcessLoadReply) error {
+	ctx := context.Background()
+	files := fstest.MapFS{}
+	for path, data := range args.Files {
+		files[path] = &fstest.MapFile{Data: data}
+	}
+	repo := pluginProcessRepository{fs: files, index: args.Index, gopath: args.Gopath, importPath: args.ImportPath}
+
+	config := PluginConfig{
+		SourceCodeRepository: repo,
+		PluginOptions:        args.PluginOptions,
+		PluginFactoryName:    args.FactoryName,
+		Stdout:               s.stdout,
+		Stderr:               s.stderr,
+		Sandbox:              args.Sandbox,
+		Env:                  args.Env,
+		Network:              args.Network,
+		Filesystem:           args.Filesystem,
+	}
+
+	var plugins []PluginProcessPlugin
+	switch args.Kind {
+	case pluginProcessKindResource:
+		plugin, err := s.engine.NewResourcePlugin(ctx, config)
+		if err != nil {
+			return err
+		}
+		plugins = append(plugins, s.register(args.Handle, args.Kind, "", plugin))
+
+	case pluginProcessKindDataSource:
+		plugin, err := s.engine.NewDataSourcePlugin(ctx, config)
+		if err != nil {
+			return err
+		}
+		plugins = append(plugins, s.register(args.Handle, args.Kind, "", plugin))
+
+	case pluginProcessKindModule:
+		module, err := s.engine.NewPluginModule(ctx, PluginModuleConfig{
+			SourceCodeRepository: config.SourceCodeRepository,
+			PluginOptions:        config.PluginOptions,
+			PluginsRegistryName:  config.PluginFactoryName,
+			Stdout:               config.Stdout,
+			Stderr:               config.Stderr,
+			Sandbox:              config.Sandbox,
+			Env:                  config.Env,
+			Network:              config.Network,
+			Filesystem:           config.Filesystem,
+		})
+		if err != nil {
+			return err
+		}
+		for name, plugin := range module.ResourcePlugins {
+			handle := args.Handle + "/" + pluginProcessKindResource + "/" + name
+			plugins = append(plugins, s.register(handle, pluginProcessKindResource, name, plugin))
+		}
+		for name, plugin := range module.DataSourcePlugins {
+			handle := args.Handle + "/" + pluginProcessKindDataSource + "/" + name
+			plugins = append(plugins, s.register(handle, pluginProcessKindDataSource, name, plugin))
+		}
+
+	default:
+		return fmt.Errorf("unknown %q plugin kind", args.Kind)
+	}
+
+	reply.Plugins = plugins
+
+	return nil
+}
+
+// register registers a loaded plugin, and returns its capabilities and the results of the supported methods
+// without arguments.
+func (s *pluginProcessServer) register(handle, kind, name string, plugin interface{}) PluginProcessPlugin {
+	s.plugins.Store(handle, plugin)
+
+	p := PluginProcessPlugin{
+		Handle:       handle,
+		Kind:         kind,
+		Name:         name,
+		Capabilities: capabilitiesOf(plugin),
+		Statics:      map[string]json.RawMessage{},
+	}
+	if Supports(plugin, CapabilityAttributesUpgrader) {
+		p.Statics["AttributesVersion"], _ = json.Marshal(plugin.(apiv1.AttributesUpgrader).AttributesVersion())
+	}
+	if Supports(plugin, CapabilityInfoProvider) {
+		p.Statics["Info"], _ = json.Marshal(plugin.(apiv1.InfoProvider).Info())
+	}
+
+	return p
+}
+
+// Call calls a plugin method, the plugin errors (and panics) are returned in the reply.
+func (s *pluginProcessServer) Call(args PluginProcessCallArgs, reply *PluginProcessCallReply) (err error) {
+	plugin, ok := s.plugins.Load(args.Handle)
+	if !ok {
+		return fmt.Errorf("unknown %q plugin", args.Handle)
+	}
+
+	method := reflect.ValueOf(plugin).MethodByName(args.Method)
+	if !method.IsValid() {
+		return fmt.Errorf("unknown %q plugin method", args.Method)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if !args.Deadline.IsZero() {
+		ctx, cancel = context.WithDeadline(ctx, args.Deadline)
+		defer cancel()
+	}
+	s.cancels.Store(args.CallID, cancel)
+	defer s.cancels.Delete(args.CallID)
+
+	// Tell the engine that all the output of the call has been written.
+	defer s.output.writeLine(fmt.Sprintf("%s %d", pluginProcessOutputEnd, args.CallID))
+	ctx = apiv1.ContextWithRequestInfo(ctx, args.RequestInfo)
+	ctx = apiv1.ContextWithLogger(ctx, pluginProcessLogger{callID: args.CallID, output: s.output})
+
+	defer func() {
+		if r := recover(); r != nil {
+			reply.Err = &PluginProcessError{Message: fmt.Sprintf("plugin panicked: %v", r)}
+		}
+	}()
+
+	methodType := method.Type()
+	in := []reflect.Value{}
+	if methodType.NumIn() > 0 {
+		in = append(in, reflect.ValueOf(ctx))
+	}
+	if methodType.NumIn() > 1 {
+		request := reflect.New(methodType.In(1))
+		err := json.Unmarshal(args.Request, request.Interface())
+		if err != nil {
+			return fmt.Errorf("could not unmarshal request: %w", err)
+		}
+		in = append(in, request.Elem())
+	}
+
+	out := method.Call(in)
+	if errValue := out[len(out)-1]; !errValue.IsNil() {
+		reply.Err = newPluginProcessError(errValue.Interface().(error))
+		return nil
+	}
+
+	if len(out) > 1 {
+		reply.Response, err = json.Marshal(out[0].Interface())
+		if err != nil {
+			return fmt.Errorf("could not marshal response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Cancel cancels the context of a plugin method call, the calls with an exceeded deadline are done by their own
+// context deadline.
+func (s *pluginProcessServer) Cancel(args PluginProcessCancelArgs, _ *struct{}) error {
+	if args.DeadlineExceeded {
+		return nil
+	}
+
+	if cancel, ok := s.cancels.Load(args.CallID); ok {
+		cancel.(context.CancelFunc)()
+	}
+
+	return nil
+}
+
+// pluginProcessRepository is the source code repository sent by the engine to the plugin process.
+type pluginProcessRepository struct {
+	fs         fstest.MapFS
+	index      string
+	gopath     string
+	importPath string
+}
+
+func (r pluginProcessRepository) FS(ctx context.Context) fs.FS      { return r.fs }
+func (r pluginProcessRepository) Index(ctx context.Context) string  { return r.index }
+func (r pluginProcessRepository) Gopath(ctx context.Context) string { return r.gopath }
+func (r pluginProcessRepository) ImportPath(ctx context.Context) string {
+	return r.importPath
+}
+
+// pluginProcessOutput writes the plugin process output lines to the engine.
+type pluginProcessOutput struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (o *pluginProcessOutput) writeLine(line string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, _ = io.WriteString(o.w, line+"\n")
+}
+
+// pluginProcessLineWriter writes the plugin standard output and error lines to the engine.
+type pluginProcessLineWriter struct {
+	kind   string
+	output *pluginProcessOutput
+	mu     sync.Mutex
+	buf    []byte
+}
+
+func (w *pluginProcessLineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := strings.IndexByte(string(w.buf), '\n')
+		if i < 0 {
+			break
+		}
+		w.output.writeLine(w.kind + " " + string(w.buf[:i]))
+		w.buf = w.buf[i+1:]
+	}
+
+	return len(p), nil
+}
+
+const (
+	pluginProcessLogLevelDebug   = "debug"
+	pluginProcessLogLevelInfo    = "info"
+	pluginProcessLogLevelWarning = "warning"
+	pluginProcessLogLevelError   = "error"
+)
+
+// pluginProcessLogMessage is a plugin log message sent to the engine, logged with the logger of the plugin
+// operation.
+type pluginProcessLogMessage struct {
+	CallID  uint64                 `json:"call_id"`
+	Level   string                 `json:"level"`
+	Message string                 `json:"message"`
+	Values  map[string]interface{} `json:"values,omitempty"`
+}
+
+// pluginProcessLogger is the plugin logger of the plugin operations executed in a plugin process.
+type pluginProcessLogger struct {
+	callID uint64
+	values map[string]interface{}
+	output *pluginProcessOutput
+}
+
+func (l pluginProcessLogger) log(level, format string, args ...interface{}) {
+	data, err := json.Marshal(pluginProcessLogMessage{
+		CallID:  l.callID,
+		Level:   level,
+		Message: fmt.Sprintf(format, args...),
+		Values:  l.values,
+	})
+	if err != nil {
+		return
+	}
+	l.output.writeLine(pluginProcessOutputLog + " " + string(data))
+}
+
+func (l pluginProcessLogger) Debugf(format string, args ...interface{}) {
+	l.log(pluginProcessLogLevelDebug, format, args...)
+}
+
+func (l pluginProcessLogger) Infof(format string, args ...interface{}) {
+	l.log(pluginProcessLogLevelInfo, format, args...)
+}
+
+func (l pluginProcessLogger) Warningf(format string, args ...interface{}) {
+	l.log(pluginProcessLogLevelWarning, format, args...)
+}
+
+func (l pluginProcessLogger) Errorf(format string, args ...interface{}) {
+	l.log(pluginProcessLogLevelError, format, args...)
+}
+
+func (l pluginProcessLogger) WithValues(values map[string]interface{}) apiv1.Logger {
+	v := make(map[string]interface{}, len(l.values)+len(values))
+	for k, val := range l.values {
+		v[k] = val
+	}
+	for k, val := range values {
+		v[k] = val
+	}
+
+	return pluginProcessLogger{callID: l.callID, values: v, output: l.output}
+}
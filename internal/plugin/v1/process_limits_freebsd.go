@@ -0,0 +1,11 @@
+//go:build freebsd
+
+package v1
+
+import "syscall"
+
+// newCPUTimeRlimit returns the CPU time resource limit in seconds, with one more second as hard limit, the FreeBSD
+// resource limits are signed.
+func newCPUTimeRlimit(secs uint64) *syscall.Rlimit {
+	return &syscall.Rlimit{Cur: int64(secs), Max: int64(secs) + 1}
+}
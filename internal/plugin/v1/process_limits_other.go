@@ -0,0 +1,12 @@
+//go:build !linux && !darwin && !freebsd
+
+package v1
+
+import (
+	"fmt"
+	"time"
+)
+
+func setPluginProcessCPUTimeLimit(limit time.Duration, exceeded func()) error {
+	return fmt.Errorf("CPU time limit is not supported on this platform")
+}
@@ -0,0 +1,10 @@
+//go:build linux || darwin
+
+package v1
+
+import "syscall"
+
+// newCPUTimeRlimit returns the CPU time resource limit in seconds, with one more second as hard limit.
+func newCPUTimeRlimit(secs uint64) *syscall.Rlimit {
+	return &syscall.Rlimit{Cur: secs, Max: secs + 1}
+}
@@ -0,0 +1,29 @@
+//go:build linux || darwin || freebsd
+
+package v1
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// setPluginProcessCPUTimeLimit sets the CPU time limit of the process, when exceeded, the OS sends `SIGXCPU` and
+// `exceeded` is called, if the process is still running after one more second, the OS kills it.
+func setPluginProcessCPUTimeLimit(limit time.Duration, exceeded func()) error {
+	secs := uint64((limit + time.Second - 1) / time.Second)
+	err := syscall.Setrlimit(syscall.RLIMIT_CPU, newCPUTimeRlimit(secs))
+	if err != nil {
+		return err
+	}
+
+	sigC := make(chan os.Signal, 1)
+	signal.Notify(sigC, syscall.SIGXCPU)
+	go func() {
+		<-sigC
+		exceeded()
+	}()
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"strconv"
+	"strings"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+	"github.com/traefik/yaegi/stdlib/unsafe"
+
+	"github.com/slok/terraform-provider-goplugin/internal/plugin/storage"
+)
+
+// SandboxPolicy restricts the standard library packages that a plugin can import.
+//
+// The import patterns are package import paths (e.g: `net/http`) or package trees (e.g: `net/...`).
+type SandboxPolicy struct {
+	// AllowedImports are the only standard library packages that the plugin can import, if empty all are allowed.
+	AllowedImports []string
+	// DeniedImports are the standard library packages that the plugin can't import, these have priority
+	// over the allowed imports.
+	DeniedImports []string
+	// AllowUnsafe allows the plugin to import `unsafe` package.
+	AllowUnsafe bool
+}
+
+const unsafeImportPath = "unsafe"
+
+func (s SandboxPolicy) index() string {
+	return fmt.Sprintf("%v%v%t", s.AllowedImports, s.DeniedImports, s.AllowUnsafe)
+}
+
+// allowed returns true if the standard library package import path is allowed by the policy.
+func (s SandboxPolicy) allowed(importPath string) bool {
+	if importPath == unsafeImportPath && !s.AllowUnsafe {
+		return false
+	}
+
+	for _, pattern := range s.DeniedImports {
+		if importPathMatches(pattern, importPath) {
+			return false
+		}
+	}
+
+	if len(s.AllowedImports) == 0 {
+		return true
+	}
+
+	for _, pattern := range s.AllowedImports {
+		if importPathMatches(pattern, importPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func importPathMatches(pattern, importPath string) bool {
+	if tree := strings.TrimSuffix(pattern, "/..."); tree != pattern {
+		return importPath == tree || strings.HasPrefix(importPath, tree+"/")
+	}
+
+	return pattern == importPath
+}
+
+// symbols returns the standard library symbols that are allowed by the policy.
+func (s SandboxPolicy) symbols() interp.Exports {
+	symbols := interp.Exports{}
+	addSymbols := func(exports interp.Exports) {
+		for key, pkgSymbols := range exports {
+			// Symbols are indexed by `<import path>/<package name>`.
+			i := strings.LastIndex(key, "/")
+			if i >= 0 && !s.allowed(key[:i]) {
+				continue
+			}
+			symbols[key] = pkgSymbols
+		}
+	}
+
+	addSymbols(stdlib.Symbols)
+
+	// Yaegi only virtualizes the standard streams and the environment (`os` package) of the interpreter
+	// when `fmt` is used, so it's always added, the imports check will forbid its usage if required.
+	symbols["fmt/fmt"] = stdlib.Symbols["fmt/fmt"]
+
+	if s.AllowUnsafe {
+		addSymbols(unsafe.Symbols)
+	}
+
+	return symbols
+}
+
+// checkImports checks that the plugin source code (including vendored dependencies) doesn't import forbidden
+// standard library packages, the error will have the file and the import that is forbidden.
+func (s SandboxPolicy) checkImports(ctx context.Context, repo storage.SourceCodeRepository) error {
+	stdPkgs := map[string]bool{unsafeImportPath: true}
+	for key := range stdlib.Symbols {
+		if i := strings.LastIndex(key, "/"); i >= 0 {
+			stdPkgs[key[:i]] = true
+		}
+	}
+
+	srcRoot := fmt.Sprintf("%s/src/%s/", repo.Gopath(ctx), repo.ImportPath(ctx))
+	fileSet := token.NewFileSet()
+	return fs.WalkDir(repo.FS(ctx), ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		data, err := fs.ReadFile(repo.FS(ctx), path)
+		if err != nil {
+			return fmt.Errorf("could not read %q file: %w", path, err)
+		}
+
+		f, err := parser.ParseFile(fileSet, path, data, parser.ImportsOnly)
+		if err != nil {
+			return fmt.Errorf("could not parse %q file: %w", path, err)
+		}
+
+		for _, imp := range f.Imports {
+			importPath, err := strconv.Unquote(imp.Path.Value)
+			if err != nil {
+				return fmt.Errorf("invalid import on %q file: %w", path, err)
+			}
+
+			if stdPkgs[importPath] && !s.allowed(importPath) {
+				return fmt.Errorf("%q file imports %q package that is not allowed by the sandbox policy", strings.TrimPrefix(path, srcRoot), importPath)
+			}
+		}
+
+		return nil
+	})
+}
@@ -31,6 +31,24 @@ func (p plugin) UpdateResource(ctx context.Context, r apiv1.UpdateResourceReques
 	return nil, fmt.Errorf(p.errorMessage)
 }
 
+func (p plugin) PlanResource(ctx context.Context, r apiv1.PlanResourceRequest) (*apiv1.PlanResourceResponse, error) {
+	return nil, fmt.Errorf(p.errorMessage)
+}
+
+func (p plugin) ImportResource(ctx context.Context, r apiv1.ImportResourceRequest) (*apiv1.ImportResourceResponse, error) {
+	return nil, fmt.Errorf(p.errorMessage)
+}
+
+func (p plugin) AttributesVersion() int { return 1 }
+
+func (p plugin) UpgradeAttributes(ctx context.Context, r apiv1.UpgradeAttributesRequest) (*apiv1.UpgradeAttributesResponse, error) {
+	return nil, fmt.Errorf(p.errorMessage)
+}
+
+func (p plugin) AttributesEqual(ctx context.Context, r apiv1.AttributesEqualRequest) (*apiv1.AttributesEqualResponse, error) {
+	return nil, fmt.Errorf(p.errorMessage)
+}
+
 func NewDataSourcePlugin(opts string) (apiv1.DataSourcePlugin, error) {
 	return plugin{errorMessage: "something"}, nil
 }
@@ -38,3 +56,15 @@ func NewDataSourcePlugin(opts string) (apiv1.DataSourcePlugin, error) {
 func (p plugin) ReadDataSource(ctx context.Context, r apiv1.ReadDataSourceRequest) (*apiv1.ReadDataSourceResponse, error) {
 	return nil, fmt.Errorf(p.errorMessage)
 }
+
+func (p plugin) ValidateAttributes(ctx context.Context, r apiv1.ValidateAttributesRequest) (*apiv1.ValidateAttributesResponse, error) {
+	return nil, fmt.Errorf(p.errorMessage)
+}
+
+func (p plugin) HealthCheck(ctx context.Context, r apiv1.HealthCheckRequest) (*apiv1.HealthCheckResponse, error) {
+	return nil, fmt.Errorf(p.errorMessage)
+}
+
+func (p plugin) Close(ctx context.Context) error {
+	return fmt.Errorf(p.errorMessage)
+}
@@ -0,0 +1,203 @@
+package tf
+
+import (
+	"context"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+func NewResourcePlugin(opts string) (apiv1.ResourcePlugin, error) {
+	return plugin{}, nil
+}
+
+// The standard streams should be plugin files.
+var _ *os.File = os.Stderr
+
+type plugin struct{}
+
+func (p plugin) CreateResource(ctx context.Context, r apiv1.CreateResourceRequest) (*apiv1.CreateResourceResponse, error) {
+	return &apiv1.CreateResourceResponse{}, nil
+}
+
+// ReadResource will execute a filesystem operation on a path, the resource ID has the operation as prefix.
+func (p plugin) ReadResource(ctx context.Context, r apiv1.ReadResourceRequest) (*apiv1.ReadResourceResponse, error) {
+	op, path, _ := strings.Cut(r.ID, ":")
+	switch op {
+	case "read":
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: string(data)}, nil
+
+	case "ioutil-read":
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: string(data)}, nil
+
+	case "fs-read":
+		data, err := fs.ReadFile(os.DirFS("/"), path)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: string(data)}, nil
+
+	case "write":
+		err := os.WriteFile(path, []byte("written"), 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: "written"}, nil
+
+	case "chmod", "chown", "chdir", "name":
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		switch op {
+		case "chmod":
+			err = f.Chmod(0o644)
+		case "chown":
+			err = f.Chown(os.Getuid(), os.Getgid())
+		case "chdir":
+			err = f.Chdir()
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: f.Name()}, nil
+
+	case "createtemp":
+		f, err := os.CreateTemp(path, "tmp-*.txt")
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if !strings.HasPrefix(f.Name(), filepath.Join(path, "tmp-")) || !strings.HasSuffix(f.Name(), ".txt") {
+			return nil, fmt.Errorf("invalid temp file name %q", f.Name())
+		}
+		return &apiv1.ReadResourceResponse{Attributes: filepath.Dir(f.Name())}, nil
+
+	case "parse":
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.PackageClauseOnly)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: f.Name.Name}, nil
+
+	case "stat", "lstat":
+		stat := os.Stat
+		if op == "lstat" {
+			stat = os.Lstat
+		}
+		info, err := stat(path)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: info.Name() + " " + info.Mode().Type().String()}, nil
+
+	case "readlink":
+		target, err := os.Readlink(path)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: target}, nil
+
+	case "mkdirall":
+		err := os.MkdirAll(path, 0o755)
+		if err != nil {
+			return nil, err
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: info.Name() + " " + info.Mode().Type().String()}, nil
+
+	case "rename":
+		err := os.Rename(path, path+".renamed")
+		if err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(path + ".renamed")
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: string(data)}, nil
+
+	case "truncate":
+		err := os.Truncate(path, 2)
+		if err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: string(data)}, nil
+
+	case "chtimes":
+		t := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		err := os.Chtimes(path, t, t)
+		if err != nil {
+			return nil, err
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: info.ModTime().UTC().Format(time.RFC3339)}, nil
+
+	case "remove", "removeall":
+		remove := os.Remove
+		if op == "removeall" {
+			remove = os.RemoveAll
+		}
+		err := remove(path)
+		if err != nil {
+			return nil, err
+		}
+		_, err = os.Lstat(path)
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("%q has not been removed", path)
+		}
+		return &apiv1.ReadResourceResponse{Attributes: "removed"}, nil
+
+	case "walk":
+		paths := []string{}
+		err := filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			paths = append(paths, path)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: strings.Join(paths, ",")}, nil
+	}
+
+	return nil, fmt.Errorf("unknown filesystem operation")
+}
+
+func (p plugin) DeleteResource(ctx context.Context, r apiv1.DeleteResourceRequest) (*apiv1.DeleteResourceResponse, error) {
+	return &apiv1.DeleteResourceResponse{}, nil
+}
+
+func (p plugin) UpdateResource(ctx context.Context, r apiv1.UpdateResourceRequest) (*apiv1.UpdateResourceResponse, error) {
+	return &apiv1.UpdateResourceResponse{}, nil
+}
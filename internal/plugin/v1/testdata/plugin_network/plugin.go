@@ -0,0 +1,151 @@
+package tf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+func NewResourcePlugin(opts string) (apiv1.ResourcePlugin, error) {
+	return plugin{}, nil
+}
+
+type plugin struct{}
+
+func (p plugin) CreateResource(ctx context.Context, r apiv1.CreateResourceRequest) (*apiv1.CreateResourceResponse, error) {
+	return &apiv1.CreateResourceResponse{}, nil
+}
+
+// ReadResource will connect to the resource ID, the ID has the connection method as prefix.
+func (p plugin) ReadResource(ctx context.Context, r apiv1.ReadResourceRequest) (*apiv1.ReadResourceResponse, error) {
+	switch {
+	case strings.HasPrefix(r.ID, "get:"):
+		resp, err := http.Get(strings.TrimPrefix(r.ID, "get:"))
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: string(body)}, nil
+
+	case strings.HasPrefix(r.ID, "client:"):
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimPrefix(r.ID, "client:"), nil)
+		if err != nil {
+			return nil, err
+		}
+		client := &http.Client{Transport: http.DefaultTransport}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: string(body)}, nil
+
+	case strings.HasPrefix(r.ID, "notransport:"):
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimPrefix(r.ID, "notransport:"), nil)
+		if err != nil {
+			return nil, err
+		}
+		client := &http.Client{Timeout: 5 * time.Second}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: string(body)}, nil
+
+	case strings.HasPrefix(r.ID, "notransportget:"):
+		client := &http.Client{}
+		resp, err := client.Get(strings.TrimPrefix(r.ID, "notransportget:"))
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: string(body)}, nil
+
+	case strings.HasPrefix(r.ID, "reflect:"):
+		// Try to remove the guarded dial function of the default transport and client.
+		unguard(reflect.ValueOf(http.DefaultTransport))
+		unguard(reflect.ValueOf(http.DefaultClient))
+		resp, err := http.Get(strings.TrimPrefix(r.ID, "reflect:"))
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: string(body)}, nil
+
+	case strings.HasPrefix(r.ID, "lookup:"):
+		addrs, err := net.LookupHost(strings.TrimPrefix(r.ID, "lookup:"))
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.ReadResourceResponse{Attributes: strings.Join(addrs, ",")}, nil
+
+	case strings.HasPrefix(r.ID, "dial:"):
+		conn, err := net.Dial("tcp", strings.TrimPrefix(r.ID, "dial:"))
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		return &apiv1.ReadResourceResponse{Attributes: "connected"}, nil
+	}
+
+	return nil, fmt.Errorf("unknown connection method")
+}
+
+// unguard sets to zero the dial functions found on the value fields.
+func unguard(v reflect.Value) {
+	defer func() { _ = recover() }()
+
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	if f := v.FieldByName("DialContext"); f.IsValid() {
+		f.Set(reflect.Zero(f.Type()))
+		return
+	}
+	for i := 0; i < v.NumField(); i++ {
+		unguard(v.Field(i))
+	}
+}
+
+func (p plugin) DeleteResource(ctx context.Context, r apiv1.DeleteResourceRequest) (*apiv1.DeleteResourceResponse, error) {
+	return &apiv1.DeleteResourceResponse{}, nil
+}
+
+func (p plugin) UpdateResource(ctx context.Context, r apiv1.UpdateResourceRequest) (*apiv1.UpdateResourceResponse, error) {
+	return &apiv1.UpdateResourceResponse{}, nil
+}
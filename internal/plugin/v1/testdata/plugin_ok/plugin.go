@@ -1,6 +1,9 @@
 package tf
 
 import (
+	"context"
+	"fmt"
+
 	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
 )
 
@@ -12,4 +15,40 @@ func NewDataSourcePlugin(configuration string) (apiv1.DataSourcePlugin, error) {
 	return plugin{}, nil
 }
 
+func Plugins() apiv1.PluginsRegistry {
+	return apiv1.PluginsRegistry{
+		ResourcePlugins: map[string]apiv1.ResourcePluginFactory{
+			"ok": NewResourcePlugin,
+		},
+		DataSourcePlugins: map[string]apiv1.DataSourcePluginFactory{
+			"ok": NewDataSourcePlugin,
+		},
+	}
+}
+
 type plugin struct{}
+
+func (p plugin) ValidateAttributes(ctx context.Context, r apiv1.ValidateAttributesRequest) (*apiv1.ValidateAttributesResponse, error) {
+	if r.Attributes == "invalid" {
+		return &apiv1.ValidateAttributesResponse{
+			Diagnostics: []apiv1.Diagnostic{{Summary: "Invalid", Detail: "invalid attributes", AttributePath: "test1"}},
+		}, nil
+	}
+
+	return &apiv1.ValidateAttributesResponse{}, nil
+}
+
+func (p plugin) HealthCheck(ctx context.Context, r apiv1.HealthCheckRequest) (*apiv1.HealthCheckResponse, error) {
+	return &apiv1.HealthCheckResponse{
+		Diagnostics: []apiv1.Diagnostic{{Severity: apiv1.DiagnosticSeverityWarning, Summary: "Healthy", Detail: "healthy test"}},
+	}, nil
+}
+
+func (p plugin) Close(ctx context.Context) error {
+	fmt.Println("closed")
+	return nil
+}
+
+func (p plugin) Info() apiv1.PluginInfo {
+	return apiv1.PluginInfo{Name: "ok", Version: "v1.0.0", Description: "Test plugin", DocsURL: "https://test.test"}
+}
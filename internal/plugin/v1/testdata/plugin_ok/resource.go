@@ -3,27 +3,70 @@ package tf
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
 	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
 )
 
 func (p plugin) CreateResource(ctx context.Context, r apiv1.CreateResourceRequest) (*apiv1.CreateResourceResponse, error) {
+	logger := apiv1.LoggerFromContext(ctx).WithValues(map[string]interface{}{"attributes": r.Attributes})
+	logger.Infof("Creating resource %q", r.Attributes)
+
+	// Use the standard input, output and error.
+	if r.Attributes == "output" {
+		in, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("could not read stdin: %w", err)
+		}
+		fmt.Printf("stdout test (stdin: %q)\n", in)
+		fmt.Fprintln(os.Stderr, "stderr test")
+	}
+
 	return &apiv1.CreateResourceResponse{
-		ID: r.Attributes + "_test1",
+		ID:      r.Attributes + "_test1",
+		Outputs: r.Attributes + "_outputs",
+		Private: r.Attributes + "_private",
 	}, nil
 }
 
 func (p plugin) DeleteResource(ctx context.Context, r apiv1.DeleteResourceRequest) (*apiv1.DeleteResourceResponse, error) {
-	if r.ID != "test1" {
+	if r.ID != "test1" || r.AttributesState != "this is a test" {
 		return nil, fmt.Errorf("test failed")
 	}
 
-	return &apiv1.DeleteResourceResponse{}, nil
+	return &apiv1.DeleteResourceResponse{
+		Diagnostics: []apiv1.Diagnostic{
+			{Severity: apiv1.DiagnosticSeverityWarning, Summary: "Deleted", Detail: "test1 deleted", AttributePath: "test1"},
+		},
+	}, nil
 }
 
 func (p plugin) ReadResource(ctx context.Context, r apiv1.ReadResourceRequest) (*apiv1.ReadResourceResponse, error) {
+	if r.ID == "missing" {
+		return nil, fmt.Errorf("resource %q: %w", r.ID, apiv1.ErrNotFound)
+	}
+
+	if r.ID == "request_info" {
+		info := apiv1.RequestInfoFromContext(ctx)
+		return &apiv1.ReadResourceResponse{
+			Attributes: fmt.Sprintf("%s,%s,%s,%s,%s,%s", info.PluginID, info.Operation, info.ResourceType, info.ResourceID, info.Workspace, info.ProviderVersion),
+		}, nil
+	}
+
+	if strings.HasPrefix(r.ID, "env:") {
+		return &apiv1.ReadResourceResponse{Attributes: os.Getenv(strings.TrimPrefix(r.ID, "env:"))}, nil
+	}
+
+	if r.ID == "unavailable" {
+		return nil, apiv1.Retryable(fmt.Errorf("resource %q is not available yet", r.ID))
+	}
+
 	return &apiv1.ReadResourceResponse{
-		Attributes: r.ID + "_test1",
+		Attributes: r.ID + r.AttributesState + "_test1",
+		Outputs:    r.ID + "_outputs",
+		Private:    r.Private + "_read",
 	}, nil
 }
 
@@ -32,5 +75,47 @@ func (p plugin) UpdateResource(ctx context.Context, r apiv1.UpdateResourceReques
 		return nil, fmt.Errorf("test failed")
 	}
 
-	return &apiv1.UpdateResourceResponse{}, nil
+	return &apiv1.UpdateResourceResponse{
+		Outputs: r.Attributes + "_outputs",
+	}, nil
+}
+
+func (p plugin) PlanResource(ctx context.Context, r apiv1.PlanResourceRequest) (*apiv1.PlanResourceResponse, error) {
+	if r.ID != "test1" || r.Attributes != "This is" || r.AttributesState != " a test" {
+		return nil, fmt.Errorf("test failed")
+	}
+
+	return &apiv1.PlanResourceResponse{
+		Attributes:      r.Attributes + r.AttributesState,
+		RequiresReplace: []string{"test1"},
+	}, nil
+}
+
+func (p plugin) ImportResource(ctx context.Context, r apiv1.ImportResourceRequest) (*apiv1.ImportResourceResponse, error) {
+	if r.ID != "owner:name" {
+		return nil, fmt.Errorf("test failed")
+	}
+
+	return &apiv1.ImportResourceResponse{
+		ID:         "owner/name",
+		Attributes: "owner_name",
+	}, nil
+}
+
+func (p plugin) AttributesVersion() int { return 2 }
+
+func (p plugin) UpgradeAttributes(ctx context.Context, r apiv1.UpgradeAttributesRequest) (*apiv1.UpgradeAttributesResponse, error) {
+	if r.FromVersion != 1 {
+		return nil, fmt.Errorf("test failed")
+	}
+
+	return &apiv1.UpgradeAttributesResponse{
+		Attributes: r.Attributes + "_upgraded",
+	}, nil
+}
+
+func (p plugin) AttributesEqual(ctx context.Context, r apiv1.AttributesEqualRequest) (*apiv1.AttributesEqualResponse, error) {
+	return &apiv1.AttributesEqualResponse{
+		Equal: strings.EqualFold(r.Attributes, r.AttributesState),
+	}, nil
 }
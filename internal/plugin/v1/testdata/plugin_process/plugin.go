@@ -0,0 +1,123 @@
+package tf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+func NewResourcePlugin(opts string) (apiv1.ResourcePlugin, error) {
+	if opts == "print-load" {
+		fmt.Println("loaded")
+	}
+	return plugin{}, nil
+}
+
+type plugin struct{}
+
+func (p plugin) CreateResource(ctx context.Context, r apiv1.CreateResourceRequest) (*apiv1.CreateResourceResponse, error) {
+	return &apiv1.CreateResourceResponse{ID: r.Attributes + "_id"}, nil
+}
+
+// ReadResource will execute a plugin process behavior, the resource ID is the behavior.
+func (p plugin) ReadResource(ctx context.Context, r apiv1.ReadResourceRequest) (*apiv1.ReadResourceResponse, error) {
+	switch r.ID {
+	case "pid":
+		return &apiv1.ReadResourceResponse{Attributes: strconv.Itoa(os.Getpid())}, nil
+
+	case "output":
+		fmt.Println("stdout test")
+		fmt.Fprintln(os.Stderr, "stderr test")
+		apiv1.LoggerFromContext(ctx).WithValues(map[string]interface{}{"k": "v"}).Infof("log test %d", 42)
+		return &apiv1.ReadResourceResponse{Attributes: "output"}, nil
+
+	case "panic":
+		panic("panic test")
+
+	case "goroutine-panic":
+		done := make(chan struct{})
+		go func() {
+			panic("goroutine panic test")
+		}()
+		<-done
+		return nil, nil
+
+	case "loop":
+		n := 0
+		for {
+			n++
+		}
+
+	case "memory":
+		data := [][]byte{}
+		for {
+			data = append(data, make([]byte, 1<<20))
+		}
+
+	case "hang":
+		select {}
+
+	case "slow":
+		time.Sleep(10 * time.Second)
+		return &apiv1.ReadResourceResponse{Attributes: "slow"}, nil
+
+	case "context":
+		<-ctx.Done()
+		return nil, ctx.Err()
+
+	case "env":
+		// The started processes inherit the plugin process environment.
+		r, w, err := os.Pipe()
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		proc, err := os.StartProcess("/bin/sh", []string{"sh", "-c", "echo -n ${TEST_GOPLUGIN_PROCESS_HOST}-${TEST_GOPLUGIN_PROCESS_PLUGIN}"}, &os.ProcAttr{
+			Files: []*os.File{nil, w, nil},
+		})
+		w.Close()
+		if err != nil {
+			return nil, err
+		}
+		out, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		_, _ = proc.Wait()
+		return &apiv1.ReadResourceResponse{Attributes: string(out)}, nil
+
+	case "notfound":
+		return nil, fmt.Errorf("missing: %w", apiv1.ErrNotFound)
+
+	case "retryable":
+		return nil, fmt.Errorf("later: %w", apiv1.ErrRetryable)
+
+	case "request-info":
+		info := apiv1.RequestInfoFromContext(ctx)
+		return &apiv1.ReadResourceResponse{Attributes: strings.Join([]string{info.PluginID, info.Operation}, ",")}, nil
+	}
+
+	return &apiv1.ReadResourceResponse{Attributes: r.ID}, nil
+}
+
+func (p plugin) DeleteResource(ctx context.Context, r apiv1.DeleteResourceRequest) (*apiv1.DeleteResourceResponse, error) {
+	return &apiv1.DeleteResourceResponse{}, nil
+}
+
+func (p plugin) UpdateResource(ctx context.Context, r apiv1.UpdateResourceRequest) (*apiv1.UpdateResourceResponse, error) {
+	return &apiv1.UpdateResourceResponse{}, nil
+}
+
+func (p plugin) HealthCheck(ctx context.Context, r apiv1.HealthCheckRequest) (*apiv1.HealthCheckResponse, error) {
+	return &apiv1.HealthCheckResponse{Diagnostics: []apiv1.Diagnostic{{Summary: "healthy"}}}, nil
+}
+
+func (p plugin) Info() apiv1.PluginInfo {
+	return apiv1.PluginInfo{Name: "process", Version: "v1.0.0"}
+}
@@ -0,0 +1,251 @@
+// composedgen generates the Yaegi composed interface wrappers for the plugin v1 API.
+//
+// Yaegi wraps the interpreted values that are returned as a binary interface (e.g: a plugin
+// returned as `apiv1.ResourcePlugin` by a plugin factory) using a wrapper that only implements the
+// methods of that interface, so any optional interface implemented by the plugin would be lost.
+// Yaegi solves this with composed interface wrappers, it selects the first wrapper whose fields
+// (`W<method>`) are all methods of the interpreted type, and binds only those methods.
+//
+// The wrapper fields are the methods, so every method set needs its own wrapper type. To keep them
+// small, this command generates a named function type per method (e.g: `WPlanResource`) that
+// implements the method, and the composed wrappers only embed these types.
+//
+// A wrapper is needed for every combination of the optional interfaces: the wrapper is selected when
+// the plugin crosses the interpreter boundary, and the methods that it doesn't bind can't be obtained
+// later, neither from the host nor from interpreted code (the interpreted type of the plugin is lost).
+// The engine obtains the optional interfaces methods from the returned wrapper once, in a single struct
+// with a function per method (check `v1.pluginMethods`).
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+type composedInterface struct {
+	base      reflect.Type
+	optionals []reflect.Type
+}
+
+func interfaceOf(v interface{}) reflect.Type { return reflect.TypeOf(v).Elem() }
+
+// composedInterfaces are the plugin interfaces that can implement optional interfaces.
+var composedInterfaces = []composedInterface{
+	{
+		base: interfaceOf((*apiv1.ResourcePlugin)(nil)),
+		optionals: []reflect.Type{
+			interfaceOf((*apiv1.ResourcePlanner)(nil)),
+			interfaceOf((*apiv1.AttributesValidator)(nil)),
+			interfaceOf((*apiv1.ResourceImporter)(nil)),
+			interfaceOf((*apiv1.AttributesUpgrader)(nil)),
+			interfaceOf((*apiv1.AttributesComparator)(nil)),
+			interfaceOf((*apiv1.HealthChecker)(nil)),
+			interfaceOf((*apiv1.Closer)(nil)),
+			interfaceOf((*apiv1.InfoProvider)(nil)),
+		},
+	},
+	{
+		base: interfaceOf((*apiv1.DataSourcePlugin)(nil)),
+		optionals: []reflect.Type{
+			interfaceOf((*apiv1.AttributesValidator)(nil)),
+			interfaceOf((*apiv1.HealthChecker)(nil)),
+			interfaceOf((*apiv1.Closer)(nil)),
+			interfaceOf((*apiv1.InfoProvider)(nil)),
+		},
+	},
+}
+
+// wrapperPfx is the prefix of the Yaegi interface wrappers generated by `yaegi extract`.
+const wrapperPfx = "_github_com_slok_terraform_provider_goplugin_pkg_api_v1_"
+
+func main() {
+	out := flag.String("out", "wrapper_composed.go", "the output file")
+	flag.Parse()
+
+	src, err := generate()
+	if err != nil {
+		log.Fatalf("could not generate composed wrappers: %s", err)
+	}
+
+	err = os.WriteFile(*out, src, 0o644)
+	if err != nil {
+		log.Fatalf("could not write composed wrappers: %s", err)
+	}
+}
+
+func generate() ([]byte, error) {
+	imports := map[string]bool{"reflect": true}
+	init := &bytes.Buffer{}
+	types := &bytes.Buffer{}
+	methods := map[string]reflect.Method{}
+
+	for _, ci := range composedInterfaces {
+		fmt.Fprintf(init, "\tMapTypes[reflect.ValueOf((*%s%s)(nil))] = []reflect.Type{\n", wrapperPfx, ci.base.Name())
+
+		for _, mask := range combinations(ci.optionals) {
+			name := fmt.Sprintf("composed%s%d", ci.base.Name(), mask)
+			ifaces := []reflect.Type{ci.base}
+			for i, o := range ci.optionals {
+				if mask&(1<<i) != 0 {
+					ifaces = append(ifaces, o)
+				}
+			}
+
+			fields := []string{"IValue interface{}"}
+			for _, m := range interfaceMethods(ifaces) {
+				methods[m.Name] = m
+				fields = append(fields, "W"+m.Name)
+			}
+
+			names := []string{}
+			for _, iface := range ifaces {
+				names = append(names, iface.Name())
+			}
+
+			fmt.Fprintf(init, "\t\treflect.TypeOf(%s{}),\n", name)
+			fmt.Fprintf(types, "\n// %s is the composed wrapper of %s.\n", name, strings.Join(names, ", "))
+			fmt.Fprintf(types, "type %s struct{ %s }\n", name, strings.Join(fields, "; "))
+		}
+
+		fmt.Fprintf(init, "\t}\n")
+	}
+
+	methodTypes := &bytes.Buffer{}
+	for _, name := range sortedKeys(methods) {
+		writeMethodType(methodTypes, imports, methods[name])
+	}
+
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "// Code generated by 'go run ./composedgen'. DO NOT EDIT.\n\npackage yaegicustom\n\nimport (\n")
+	for _, p := range sortedKeys(imports) {
+		fmt.Fprintf(b, "\t%q\n", p)
+	}
+	fmt.Fprintf(b, ")\n%s\nfunc init() {\n%s}\n%s", methodTypes.String(), init.String(), types.String())
+
+	return format.Source(b.Bytes())
+}
+
+// combinations returns the bitmasks of all the non empty combinations of the optional interfaces, sorted
+// from the ones with more methods to the ones with less, Yaegi will use the first one that the interpreted
+// type implements.
+func combinations(optionals []reflect.Type) []int {
+	methods := func(mask int) int {
+		n := 0
+		for i, o := range optionals {
+			if mask&(1<<i) != 0 {
+				n += o.NumMethod()
+			}
+		}
+		return n
+	}
+
+	masks := []int{}
+	for mask := 1; mask < 1<<len(optionals); mask++ {
+		masks = append(masks, mask)
+	}
+	sort.SliceStable(masks, func(i, j int) bool { return methods(masks[i]) > methods(masks[j]) })
+
+	return masks
+}
+
+func interfaceMethods(ifaces []reflect.Type) []reflect.Method {
+	methods := []reflect.Method{}
+	for _, iface := range ifaces {
+		for i := 0; i < iface.NumMethod(); i++ {
+			methods = append(methods, iface.Method(i))
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Name < methods[j].Name })
+
+	return methods
+}
+
+// writeMethodType writes the function type of a method that implements the method, so the composed wrappers
+// implement the method by embedding it.
+func writeMethodType(b *bytes.Buffer, imports map[string]bool, m reflect.Method) {
+	args := []string{}
+	for i := 0; i < m.Type.NumIn(); i++ {
+		a := fmt.Sprintf("a%d", i)
+		if m.Type.IsVariadic() && i == m.Type.NumIn()-1 {
+			a += "..."
+		}
+		args = append(args, a)
+	}
+
+	ret := "return "
+	if m.Type.NumOut() == 0 {
+		ret = ""
+	}
+
+	name := "W" + m.Name
+	fmt.Fprintf(b, "\n// %s is the composed wrapper field of the %s method.\n", name, m.Name)
+	fmt.Fprintf(b, "type %s func%s\n\n", name, signature(imports, m.Type))
+	fmt.Fprintf(b, "func (W %s) %s%s {\n\t%sW(%s)\n}\n", name, m.Name, signature(imports, m.Type), ret, strings.Join(args, ", "))
+}
+
+func signature(imports map[string]bool, t reflect.Type) string {
+	in := []string{}
+	for i := 0; i < t.NumIn(); i++ {
+		ts := typeString(imports, t.In(i))
+		if t.IsVariadic() && i == t.NumIn()-1 {
+			ts = "..." + typeString(imports, t.In(i).Elem())
+		}
+		in = append(in, fmt.Sprintf("a%d %s", i, ts))
+	}
+
+	out := []string{}
+	for i := 0; i < t.NumOut(); i++ {
+		out = append(out, typeString(imports, t.Out(i)))
+	}
+
+	s := "(" + strings.Join(in, ", ") + ")"
+	switch len(out) {
+	case 0:
+	case 1:
+		s += " " + out[0]
+	default:
+		s += " (" + strings.Join(out, ", ") + ")"
+	}
+
+	return s
+}
+
+func typeString(imports map[string]bool, t reflect.Type) string {
+	if t.Name() != "" && t.PkgPath() != "" {
+		imports[t.PkgPath()] = true
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + typeString(imports, t.Elem())
+	case reflect.Slice:
+		if t.Name() == "" {
+			return "[]" + typeString(imports, t.Elem())
+		}
+	case reflect.Map:
+		if t.Name() == "" {
+			return "map[" + typeString(imports, t.Key()) + "]" + typeString(imports, t.Elem())
+		}
+	}
+
+	return t.String()
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
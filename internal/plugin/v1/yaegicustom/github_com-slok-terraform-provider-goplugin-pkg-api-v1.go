@@ -13,31 +13,131 @@ import (
 func init() {
 	Symbols["github.com/slok/terraform-provider-goplugin/pkg/api/v1/v1"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"ContextWithLogger":                  reflect.ValueOf(v1.ContextWithLogger),
+		"ContextWithRequestInfo":             reflect.ValueOf(v1.ContextWithRequestInfo),
 		"DefaultDataSourcePluginFactoryName": reflect.ValueOf(constant.MakeFromLiteral("\"NewDataSourcePlugin\"", token.STRING, 0)),
+		"DefaultPluginsRegistryName":         reflect.ValueOf(constant.MakeFromLiteral("\"Plugins\"", token.STRING, 0)),
 		"DefaultResourcePluginFactoryName":   reflect.ValueOf(constant.MakeFromLiteral("\"NewResourcePlugin\"", token.STRING, 0)),
+		"DiagnosticSeverityError":            reflect.ValueOf(v1.DiagnosticSeverityError),
+		"DiagnosticSeverityWarning":          reflect.ValueOf(v1.DiagnosticSeverityWarning),
+		"ErrNotFound":                        reflect.ValueOf(&v1.ErrNotFound).Elem(),
+		"ErrRetryable":                       reflect.ValueOf(&v1.ErrRetryable).Elem(),
+		"LoggerFromContext":                  reflect.ValueOf(v1.LoggerFromContext),
+		"NoopLogger":                         reflect.ValueOf(&v1.NoopLogger).Elem(),
+		"OperationClose":                     reflect.ValueOf(constant.MakeFromLiteral("\"close\"", token.STRING, 0)),
+		"OperationCreate":                    reflect.ValueOf(constant.MakeFromLiteral("\"create\"", token.STRING, 0)),
+		"OperationDelete":                    reflect.ValueOf(constant.MakeFromLiteral("\"delete\"", token.STRING, 0)),
+		"OperationHealthCheck":               reflect.ValueOf(constant.MakeFromLiteral("\"health_check\"", token.STRING, 0)),
+		"OperationImport":                    reflect.ValueOf(constant.MakeFromLiteral("\"import\"", token.STRING, 0)),
+		"OperationPlan":                      reflect.ValueOf(constant.MakeFromLiteral("\"plan\"", token.STRING, 0)),
+		"OperationRead":                      reflect.ValueOf(constant.MakeFromLiteral("\"read\"", token.STRING, 0)),
+		"OperationUpdate":                    reflect.ValueOf(constant.MakeFromLiteral("\"update\"", token.STRING, 0)),
+		"OperationValidate":                  reflect.ValueOf(constant.MakeFromLiteral("\"validate\"", token.STRING, 0)),
+		"RequestInfoFromContext":             reflect.ValueOf(v1.RequestInfoFromContext),
+		"Retryable":                          reflect.ValueOf(v1.Retryable),
 
 		// type definitions
-		"CreateResourceRequest":   reflect.ValueOf((*v1.CreateResourceRequest)(nil)),
-		"CreateResourceResponse":  reflect.ValueOf((*v1.CreateResourceResponse)(nil)),
-		"DataSourcePlugin":        reflect.ValueOf((*v1.DataSourcePlugin)(nil)),
-		"DataSourcePluginFactory": reflect.ValueOf((*v1.DataSourcePluginFactory)(nil)),
-		"DeleteResourceRequest":   reflect.ValueOf((*v1.DeleteResourceRequest)(nil)),
-		"DeleteResourceResponse":  reflect.ValueOf((*v1.DeleteResourceResponse)(nil)),
-		"ReadDataSourceRequest":   reflect.ValueOf((*v1.ReadDataSourceRequest)(nil)),
-		"ReadDataSourceResponse":  reflect.ValueOf((*v1.ReadDataSourceResponse)(nil)),
-		"ReadResourceRequest":     reflect.ValueOf((*v1.ReadResourceRequest)(nil)),
-		"ReadResourceResponse":    reflect.ValueOf((*v1.ReadResourceResponse)(nil)),
-		"ResourcePlugin":          reflect.ValueOf((*v1.ResourcePlugin)(nil)),
-		"ResourcePluginFactory":   reflect.ValueOf((*v1.ResourcePluginFactory)(nil)),
-		"UpdateResourceRequest":   reflect.ValueOf((*v1.UpdateResourceRequest)(nil)),
-		"UpdateResourceResponse":  reflect.ValueOf((*v1.UpdateResourceResponse)(nil)),
+		"AttributesComparator":       reflect.ValueOf((*v1.AttributesComparator)(nil)),
+		"AttributesEqualRequest":     reflect.ValueOf((*v1.AttributesEqualRequest)(nil)),
+		"AttributesEqualResponse":    reflect.ValueOf((*v1.AttributesEqualResponse)(nil)),
+		"AttributesUpgrader":         reflect.ValueOf((*v1.AttributesUpgrader)(nil)),
+		"AttributesValidator":        reflect.ValueOf((*v1.AttributesValidator)(nil)),
+		"Closer":                     reflect.ValueOf((*v1.Closer)(nil)),
+		"CreateResourceRequest":      reflect.ValueOf((*v1.CreateResourceRequest)(nil)),
+		"CreateResourceResponse":     reflect.ValueOf((*v1.CreateResourceResponse)(nil)),
+		"DataSourcePlugin":           reflect.ValueOf((*v1.DataSourcePlugin)(nil)),
+		"DataSourcePluginFactory":    reflect.ValueOf((*v1.DataSourcePluginFactory)(nil)),
+		"DeleteResourceRequest":      reflect.ValueOf((*v1.DeleteResourceRequest)(nil)),
+		"DeleteResourceResponse":     reflect.ValueOf((*v1.DeleteResourceResponse)(nil)),
+		"Diagnostic":                 reflect.ValueOf((*v1.Diagnostic)(nil)),
+		"DiagnosticSeverity":         reflect.ValueOf((*v1.DiagnosticSeverity)(nil)),
+		"HealthCheckRequest":         reflect.ValueOf((*v1.HealthCheckRequest)(nil)),
+		"HealthCheckResponse":        reflect.ValueOf((*v1.HealthCheckResponse)(nil)),
+		"HealthChecker":              reflect.ValueOf((*v1.HealthChecker)(nil)),
+		"ImportResourceRequest":      reflect.ValueOf((*v1.ImportResourceRequest)(nil)),
+		"ImportResourceResponse":     reflect.ValueOf((*v1.ImportResourceResponse)(nil)),
+		"InfoProvider":               reflect.ValueOf((*v1.InfoProvider)(nil)),
+		"Logger":                     reflect.ValueOf((*v1.Logger)(nil)),
+		"PlanResourceRequest":        reflect.ValueOf((*v1.PlanResourceRequest)(nil)),
+		"PlanResourceResponse":       reflect.ValueOf((*v1.PlanResourceResponse)(nil)),
+		"PluginInfo":                 reflect.ValueOf((*v1.PluginInfo)(nil)),
+		"PluginsRegistry":            reflect.ValueOf((*v1.PluginsRegistry)(nil)),
+		"PluginsRegistryFunc":        reflect.ValueOf((*v1.PluginsRegistryFunc)(nil)),
+		"ReadDataSourceRequest":      reflect.ValueOf((*v1.ReadDataSourceRequest)(nil)),
+		"ReadDataSourceResponse":     reflect.ValueOf((*v1.ReadDataSourceResponse)(nil)),
+		"ReadResourceRequest":        reflect.ValueOf((*v1.ReadResourceRequest)(nil)),
+		"ReadResourceResponse":       reflect.ValueOf((*v1.ReadResourceResponse)(nil)),
+		"RequestInfo":                reflect.ValueOf((*v1.RequestInfo)(nil)),
+		"ResourceImporter":           reflect.ValueOf((*v1.ResourceImporter)(nil)),
+		"ResourcePlanner":            reflect.ValueOf((*v1.ResourcePlanner)(nil)),
+		"ResourcePlugin":             reflect.ValueOf((*v1.ResourcePlugin)(nil)),
+		"ResourcePluginFactory":      reflect.ValueOf((*v1.ResourcePluginFactory)(nil)),
+		"UpdateResourceRequest":      reflect.ValueOf((*v1.UpdateResourceRequest)(nil)),
+		"UpdateResourceResponse":     reflect.ValueOf((*v1.UpdateResourceResponse)(nil)),
+		"UpgradeAttributesRequest":   reflect.ValueOf((*v1.UpgradeAttributesRequest)(nil)),
+		"UpgradeAttributesResponse":  reflect.ValueOf((*v1.UpgradeAttributesResponse)(nil)),
+		"ValidateAttributesRequest":  reflect.ValueOf((*v1.ValidateAttributesRequest)(nil)),
+		"ValidateAttributesResponse": reflect.ValueOf((*v1.ValidateAttributesResponse)(nil)),
 
 		// interface wrapper definitions
-		"_DataSourcePlugin": reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_DataSourcePlugin)(nil)),
-		"_ResourcePlugin":   reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourcePlugin)(nil)),
+		"_AttributesComparator": reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesComparator)(nil)),
+		"_AttributesUpgrader":   reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesUpgrader)(nil)),
+		"_AttributesValidator":  reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesValidator)(nil)),
+		"_Closer":               reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_Closer)(nil)),
+		"_DataSourcePlugin":     reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_DataSourcePlugin)(nil)),
+		"_HealthChecker":        reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_HealthChecker)(nil)),
+		"_InfoProvider":         reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_InfoProvider)(nil)),
+		"_Logger":               reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_Logger)(nil)),
+		"_ResourceImporter":     reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourceImporter)(nil)),
+		"_ResourcePlanner":      reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourcePlanner)(nil)),
+		"_ResourcePlugin":       reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourcePlugin)(nil)),
 	}
 }
 
+// _github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesComparator is an interface wrapper for AttributesComparator type
+type _github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesComparator struct {
+	IValue           interface{}
+	WAttributesEqual func(ctx context.Context, r v1.AttributesEqualRequest) (*v1.AttributesEqualResponse, error)
+}
+
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesComparator) AttributesEqual(ctx context.Context, r v1.AttributesEqualRequest) (*v1.AttributesEqualResponse, error) {
+	return W.WAttributesEqual(ctx, r)
+}
+
+// _github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesUpgrader is an interface wrapper for AttributesUpgrader type
+type _github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesUpgrader struct {
+	IValue             interface{}
+	WAttributesVersion func() int
+	WUpgradeAttributes func(ctx context.Context, r v1.UpgradeAttributesRequest) (*v1.UpgradeAttributesResponse, error)
+}
+
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesUpgrader) AttributesVersion() int {
+	return W.WAttributesVersion()
+}
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesUpgrader) UpgradeAttributes(ctx context.Context, r v1.UpgradeAttributesRequest) (*v1.UpgradeAttributesResponse, error) {
+	return W.WUpgradeAttributes(ctx, r)
+}
+
+// _github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesValidator is an interface wrapper for AttributesValidator type
+type _github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesValidator struct {
+	IValue              interface{}
+	WValidateAttributes func(ctx context.Context, r v1.ValidateAttributesRequest) (*v1.ValidateAttributesResponse, error)
+}
+
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_AttributesValidator) ValidateAttributes(ctx context.Context, r v1.ValidateAttributesRequest) (*v1.ValidateAttributesResponse, error) {
+	return W.WValidateAttributes(ctx, r)
+}
+
+// _github_com_slok_terraform_provider_goplugin_pkg_api_v1_Closer is an interface wrapper for Closer type
+type _github_com_slok_terraform_provider_goplugin_pkg_api_v1_Closer struct {
+	IValue interface{}
+	WClose func(ctx context.Context) error
+}
+
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_Closer) Close(ctx context.Context) error {
+	return W.WClose(ctx)
+}
+
 // _github_com_slok_terraform_provider_goplugin_pkg_api_v1_DataSourcePlugin is an interface wrapper for DataSourcePlugin type
 type _github_com_slok_terraform_provider_goplugin_pkg_api_v1_DataSourcePlugin struct {
 	IValue          interface{}
@@ -48,6 +148,72 @@ func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_DataSourcePlugin
 	return W.WReadDataSource(ctx, r)
 }
 
+// _github_com_slok_terraform_provider_goplugin_pkg_api_v1_HealthChecker is an interface wrapper for HealthChecker type
+type _github_com_slok_terraform_provider_goplugin_pkg_api_v1_HealthChecker struct {
+	IValue       interface{}
+	WHealthCheck func(ctx context.Context, r v1.HealthCheckRequest) (*v1.HealthCheckResponse, error)
+}
+
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_HealthChecker) HealthCheck(ctx context.Context, r v1.HealthCheckRequest) (*v1.HealthCheckResponse, error) {
+	return W.WHealthCheck(ctx, r)
+}
+
+// _github_com_slok_terraform_provider_goplugin_pkg_api_v1_InfoProvider is an interface wrapper for InfoProvider type
+type _github_com_slok_terraform_provider_goplugin_pkg_api_v1_InfoProvider struct {
+	IValue interface{}
+	WInfo  func() v1.PluginInfo
+}
+
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_InfoProvider) Info() v1.PluginInfo {
+	return W.WInfo()
+}
+
+// _github_com_slok_terraform_provider_goplugin_pkg_api_v1_Logger is an interface wrapper for Logger type
+type _github_com_slok_terraform_provider_goplugin_pkg_api_v1_Logger struct {
+	IValue      interface{}
+	WDebugf     func(format string, args ...interface{})
+	WErrorf     func(format string, args ...interface{})
+	WInfof      func(format string, args ...interface{})
+	WWarningf   func(format string, args ...interface{})
+	WWithValues func(values map[string]interface{}) v1.Logger
+}
+
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_Logger) Debugf(format string, args ...interface{}) {
+	W.WDebugf(format, args...)
+}
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_Logger) Errorf(format string, args ...interface{}) {
+	W.WErrorf(format, args...)
+}
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_Logger) Infof(format string, args ...interface{}) {
+	W.WInfof(format, args...)
+}
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_Logger) Warningf(format string, args ...interface{}) {
+	W.WWarningf(format, args...)
+}
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_Logger) WithValues(values map[string]interface{}) v1.Logger {
+	return W.WWithValues(values)
+}
+
+// _github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourceImporter is an interface wrapper for ResourceImporter type
+type _github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourceImporter struct {
+	IValue          interface{}
+	WImportResource func(ctx context.Context, r v1.ImportResourceRequest) (*v1.ImportResourceResponse, error)
+}
+
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourceImporter) ImportResource(ctx context.Context, r v1.ImportResourceRequest) (*v1.ImportResourceResponse, error) {
+	return W.WImportResource(ctx, r)
+}
+
+// _github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourcePlanner is an interface wrapper for ResourcePlanner type
+type _github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourcePlanner struct {
+	IValue        interface{}
+	WPlanResource func(ctx context.Context, r v1.PlanResourceRequest) (*v1.PlanResourceResponse, error)
+}
+
+func (W _github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourcePlanner) PlanResource(ctx context.Context, r v1.PlanResourceRequest) (*v1.PlanResourceResponse, error) {
+	return W.WPlanResource(ctx, r)
+}
+
 // _github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourcePlugin is an interface wrapper for ResourcePlugin type
 type _github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourcePlugin struct {
 	IValue          interface{}
@@ -0,0 +1,3953 @@
+// Code generated by 'go run ./composedgen'. DO NOT EDIT.
+
+package yaegicustom
+
+import (
+	"context"
+	"github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+	"reflect"
+)
+
+// WAttributesEqual is the composed wrapper field of the AttributesEqual method.
+type WAttributesEqual func(a0 context.Context, a1 v1.AttributesEqualRequest) (*v1.AttributesEqualResponse, error)
+
+func (W WAttributesEqual) AttributesEqual(a0 context.Context, a1 v1.AttributesEqualRequest) (*v1.AttributesEqualResponse, error) {
+	return W(a0, a1)
+}
+
+// WAttributesVersion is the composed wrapper field of the AttributesVersion method.
+type WAttributesVersion func() int
+
+func (W WAttributesVersion) AttributesVersion() int {
+	return W()
+}
+
+// WClose is the composed wrapper field of the Close method.
+type WClose func(a0 context.Context) error
+
+func (W WClose) Close(a0 context.Context) error {
+	return W(a0)
+}
+
+// WCreateResource is the composed wrapper field of the CreateResource method.
+type WCreateResource func(a0 context.Context, a1 v1.CreateResourceRequest) (*v1.CreateResourceResponse, error)
+
+func (W WCreateResource) CreateResource(a0 context.Context, a1 v1.CreateResourceRequest) (*v1.CreateResourceResponse, error) {
+	return W(a0, a1)
+}
+
+// WDeleteResource is the composed wrapper field of the DeleteResource method.
+type WDeleteResource func(a0 context.Context, a1 v1.DeleteResourceRequest) (*v1.DeleteResourceResponse, error)
+
+func (W WDeleteResource) DeleteResource(a0 context.Context, a1 v1.DeleteResourceRequest) (*v1.DeleteResourceResponse, error) {
+	return W(a0, a1)
+}
+
+// WHealthCheck is the composed wrapper field of the HealthCheck method.
+type WHealthCheck func(a0 context.Context, a1 v1.HealthCheckRequest) (*v1.HealthCheckResponse, error)
+
+func (W WHealthCheck) HealthCheck(a0 context.Context, a1 v1.HealthCheckRequest) (*v1.HealthCheckResponse, error) {
+	return W(a0, a1)
+}
+
+// WImportResource is the composed wrapper field of the ImportResource method.
+type WImportResource func(a0 context.Context, a1 v1.ImportResourceRequest) (*v1.ImportResourceResponse, error)
+
+func (W WImportResource) ImportResource(a0 context.Context, a1 v1.ImportResourceRequest) (*v1.ImportResourceResponse, error) {
+	return W(a0, a1)
+}
+
+// WInfo is the composed wrapper field of the Info method.
+type WInfo func() v1.PluginInfo
+
+func (W WInfo) Info() v1.PluginInfo {
+	return W()
+}
+
+// WPlanResource is the composed wrapper field of the PlanResource method.
+type WPlanResource func(a0 context.Context, a1 v1.PlanResourceRequest) (*v1.PlanResourceResponse, error)
+
+func (W WPlanResource) PlanResource(a0 context.Context, a1 v1.PlanResourceRequest) (*v1.PlanResourceResponse, error) {
+	return W(a0, a1)
+}
+
+// WReadDataSource is the composed wrapper field of the ReadDataSource method.
+type WReadDataSource func(a0 context.Context, a1 v1.ReadDataSourceRequest) (*v1.ReadDataSourceResponse, error)
+
+func (W WReadDataSource) ReadDataSource(a0 context.Context, a1 v1.ReadDataSourceRequest) (*v1.ReadDataSourceResponse, error) {
+	return W(a0, a1)
+}
+
+// WReadResource is the composed wrapper field of the ReadResource method.
+type WReadResource func(a0 context.Context, a1 v1.ReadResourceRequest) (*v1.ReadResourceResponse, error)
+
+func (W WReadResource) ReadResource(a0 context.Context, a1 v1.ReadResourceRequest) (*v1.ReadResourceResponse, error) {
+	return W(a0, a1)
+}
+
+// WUpdateResource is the composed wrapper field of the UpdateResource method.
+type WUpdateResource func(a0 context.Context, a1 v1.UpdateResourceRequest) (*v1.UpdateResourceResponse, error)
+
+func (W WUpdateResource) UpdateResource(a0 context.Context, a1 v1.UpdateResourceRequest) (*v1.UpdateResourceResponse, error) {
+	return W(a0, a1)
+}
+
+// WUpgradeAttributes is the composed wrapper field of the UpgradeAttributes method.
+type WUpgradeAttributes func(a0 context.Context, a1 v1.UpgradeAttributesRequest) (*v1.UpgradeAttributesResponse, error)
+
+func (W WUpgradeAttributes) UpgradeAttributes(a0 context.Context, a1 v1.UpgradeAttributesRequest) (*v1.UpgradeAttributesResponse, error) {
+	return W(a0, a1)
+}
+
+// WValidateAttributes is the composed wrapper field of the ValidateAttributes method.
+type WValidateAttributes func(a0 context.Context, a1 v1.ValidateAttributesRequest) (*v1.ValidateAttributesResponse, error)
+
+func (W WValidateAttributes) ValidateAttributes(a0 context.Context, a1 v1.ValidateAttributesRequest) (*v1.ValidateAttributesResponse, error) {
+	return W(a0, a1)
+}
+
+func init() {
+	MapTypes[reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_ResourcePlugin)(nil))] = []reflect.Type{
+		reflect.TypeOf(composedResourcePlugin255{}),
+		reflect.TypeOf(composedResourcePlugin127{}),
+		reflect.TypeOf(composedResourcePlugin191{}),
+		reflect.TypeOf(composedResourcePlugin223{}),
+		reflect.TypeOf(composedResourcePlugin239{}),
+		reflect.TypeOf(composedResourcePlugin251{}),
+		reflect.TypeOf(composedResourcePlugin253{}),
+		reflect.TypeOf(composedResourcePlugin254{}),
+		reflect.TypeOf(composedResourcePlugin63{}),
+		reflect.TypeOf(composedResourcePlugin95{}),
+		reflect.TypeOf(composedResourcePlugin111{}),
+		reflect.TypeOf(composedResourcePlugin123{}),
+		reflect.TypeOf(composedResourcePlugin125{}),
+		reflect.TypeOf(composedResourcePlugin126{}),
+		reflect.TypeOf(composedResourcePlugin159{}),
+		reflect.TypeOf(composedResourcePlugin175{}),
+		reflect.TypeOf(composedResourcePlugin187{}),
+		reflect.TypeOf(composedResourcePlugin189{}),
+		reflect.TypeOf(composedResourcePlugin190{}),
+		reflect.TypeOf(composedResourcePlugin207{}),
+		reflect.TypeOf(composedResourcePlugin219{}),
+		reflect.TypeOf(composedResourcePlugin221{}),
+		reflect.TypeOf(composedResourcePlugin222{}),
+		reflect.TypeOf(composedResourcePlugin235{}),
+		reflect.TypeOf(composedResourcePlugin237{}),
+		reflect.TypeOf(composedResourcePlugin238{}),
+		reflect.TypeOf(composedResourcePlugin247{}),
+		reflect.TypeOf(composedResourcePlugin249{}),
+		reflect.TypeOf(composedResourcePlugin250{}),
+		reflect.TypeOf(composedResourcePlugin252{}),
+		reflect.TypeOf(composedResourcePlugin31{}),
+		reflect.TypeOf(composedResourcePlugin47{}),
+		reflect.TypeOf(composedResourcePlugin59{}),
+		reflect.TypeOf(composedResourcePlugin61{}),
+		reflect.TypeOf(composedResourcePlugin62{}),
+		reflect.TypeOf(composedResourcePlugin79{}),
+		reflect.TypeOf(composedResourcePlugin91{}),
+		reflect.TypeOf(composedResourcePlugin93{}),
+		reflect.TypeOf(composedResourcePlugin94{}),
+		reflect.TypeOf(composedResourcePlugin107{}),
+		reflect.TypeOf(composedResourcePlugin109{}),
+		reflect.TypeOf(composedResourcePlugin110{}),
+		reflect.TypeOf(composedResourcePlugin119{}),
+		reflect.TypeOf(composedResourcePlugin121{}),
+		reflect.TypeOf(composedResourcePlugin122{}),
+		reflect.TypeOf(composedResourcePlugin124{}),
+		reflect.TypeOf(composedResourcePlugin143{}),
+		reflect.TypeOf(composedResourcePlugin155{}),
+		reflect.TypeOf(composedResourcePlugin157{}),
+		reflect.TypeOf(composedResourcePlugin158{}),
+		reflect.TypeOf(composedResourcePlugin171{}),
+		reflect.TypeOf(composedResourcePlugin173{}),
+		reflect.TypeOf(composedResourcePlugin174{}),
+		reflect.TypeOf(composedResourcePlugin183{}),
+		reflect.TypeOf(composedResourcePlugin185{}),
+		reflect.TypeOf(composedResourcePlugin186{}),
+		reflect.TypeOf(composedResourcePlugin188{}),
+		reflect.TypeOf(composedResourcePlugin203{}),
+		reflect.TypeOf(composedResourcePlugin205{}),
+		reflect.TypeOf(composedResourcePlugin206{}),
+		reflect.TypeOf(composedResourcePlugin215{}),
+		reflect.TypeOf(composedResourcePlugin217{}),
+		reflect.TypeOf(composedResourcePlugin218{}),
+		reflect.TypeOf(composedResourcePlugin220{}),
+		reflect.TypeOf(composedResourcePlugin231{}),
+		reflect.TypeOf(composedResourcePlugin233{}),
+		reflect.TypeOf(composedResourcePlugin234{}),
+		reflect.TypeOf(composedResourcePlugin236{}),
+		reflect.TypeOf(composedResourcePlugin243{}),
+		reflect.TypeOf(composedResourcePlugin245{}),
+		reflect.TypeOf(composedResourcePlugin246{}),
+		reflect.TypeOf(composedResourcePlugin248{}),
+		reflect.TypeOf(composedResourcePlugin15{}),
+		reflect.TypeOf(composedResourcePlugin27{}),
+		reflect.TypeOf(composedResourcePlugin29{}),
+		reflect.TypeOf(composedResourcePlugin30{}),
+		reflect.TypeOf(composedResourcePlugin43{}),
+		reflect.TypeOf(composedResourcePlugin45{}),
+		reflect.TypeOf(composedResourcePlugin46{}),
+		reflect.TypeOf(composedResourcePlugin55{}),
+		reflect.TypeOf(composedResourcePlugin57{}),
+		reflect.TypeOf(composedResourcePlugin58{}),
+		reflect.TypeOf(composedResourcePlugin60{}),
+		reflect.TypeOf(composedResourcePlugin75{}),
+		reflect.TypeOf(composedResourcePlugin77{}),
+		reflect.TypeOf(composedResourcePlugin78{}),
+		reflect.TypeOf(composedResourcePlugin87{}),
+		reflect.TypeOf(composedResourcePlugin89{}),
+		reflect.TypeOf(composedResourcePlugin90{}),
+		reflect.TypeOf(composedResourcePlugin92{}),
+		reflect.TypeOf(composedResourcePlugin103{}),
+		reflect.TypeOf(composedResourcePlugin105{}),
+		reflect.TypeOf(composedResourcePlugin106{}),
+		reflect.TypeOf(composedResourcePlugin108{}),
+		reflect.TypeOf(composedResourcePlugin115{}),
+		reflect.TypeOf(composedResourcePlugin117{}),
+		reflect.TypeOf(composedResourcePlugin118{}),
+		reflect.TypeOf(composedResourcePlugin120{}),
+		reflect.TypeOf(composedResourcePlugin139{}),
+		reflect.TypeOf(composedResourcePlugin141{}),
+		reflect.TypeOf(composedResourcePlugin142{}),
+		reflect.TypeOf(composedResourcePlugin151{}),
+		reflect.TypeOf(composedResourcePlugin153{}),
+		reflect.TypeOf(composedResourcePlugin154{}),
+		reflect.TypeOf(composedResourcePlugin156{}),
+		reflect.TypeOf(composedResourcePlugin167{}),
+		reflect.TypeOf(composedResourcePlugin169{}),
+		reflect.TypeOf(composedResourcePlugin170{}),
+		reflect.TypeOf(composedResourcePlugin172{}),
+		reflect.TypeOf(composedResourcePlugin179{}),
+		reflect.TypeOf(composedResourcePlugin181{}),
+		reflect.TypeOf(composedResourcePlugin182{}),
+		reflect.TypeOf(composedResourcePlugin184{}),
+		reflect.TypeOf(composedResourcePlugin199{}),
+		reflect.TypeOf(composedResourcePlugin201{}),
+		reflect.TypeOf(composedResourcePlugin202{}),
+		reflect.TypeOf(composedResourcePlugin204{}),
+		reflect.TypeOf(composedResourcePlugin211{}),
+		reflect.TypeOf(composedResourcePlugin213{}),
+		reflect.TypeOf(composedResourcePlugin214{}),
+		reflect.TypeOf(composedResourcePlugin216{}),
+		reflect.TypeOf(composedResourcePlugin227{}),
+		reflect.TypeOf(composedResourcePlugin229{}),
+		reflect.TypeOf(composedResourcePlugin230{}),
+		reflect.TypeOf(composedResourcePlugin232{}),
+		reflect.TypeOf(composedResourcePlugin241{}),
+		reflect.TypeOf(composedResourcePlugin242{}),
+		reflect.TypeOf(composedResourcePlugin244{}),
+		reflect.TypeOf(composedResourcePlugin11{}),
+		reflect.TypeOf(composedResourcePlugin13{}),
+		reflect.TypeOf(composedResourcePlugin14{}),
+		reflect.TypeOf(composedResourcePlugin23{}),
+		reflect.TypeOf(composedResourcePlugin25{}),
+		reflect.TypeOf(composedResourcePlugin26{}),
+		reflect.TypeOf(composedResourcePlugin28{}),
+		reflect.TypeOf(composedResourcePlugin39{}),
+		reflect.TypeOf(composedResourcePlugin41{}),
+		reflect.TypeOf(composedResourcePlugin42{}),
+		reflect.TypeOf(composedResourcePlugin44{}),
+		reflect.TypeOf(composedResourcePlugin51{}),
+		reflect.TypeOf(composedResourcePlugin53{}),
+		reflect.TypeOf(composedResourcePlugin54{}),
+		reflect.TypeOf(composedResourcePlugin56{}),
+		reflect.TypeOf(composedResourcePlugin71{}),
+		reflect.TypeOf(composedResourcePlugin73{}),
+		reflect.TypeOf(composedResourcePlugin74{}),
+		reflect.TypeOf(composedResourcePlugin76{}),
+		reflect.TypeOf(composedResourcePlugin83{}),
+		reflect.TypeOf(composedResourcePlugin85{}),
+		reflect.TypeOf(composedResourcePlugin86{}),
+		reflect.TypeOf(composedResourcePlugin88{}),
+		reflect.TypeOf(composedResourcePlugin99{}),
+		reflect.TypeOf(composedResourcePlugin101{}),
+		reflect.TypeOf(composedResourcePlugin102{}),
+		reflect.TypeOf(composedResourcePlugin104{}),
+		reflect.TypeOf(composedResourcePlugin113{}),
+		reflect.TypeOf(composedResourcePlugin114{}),
+		reflect.TypeOf(composedResourcePlugin116{}),
+		reflect.TypeOf(composedResourcePlugin135{}),
+		reflect.TypeOf(composedResourcePlugin137{}),
+		reflect.TypeOf(composedResourcePlugin138{}),
+		reflect.TypeOf(composedResourcePlugin140{}),
+		reflect.TypeOf(composedResourcePlugin147{}),
+		reflect.TypeOf(composedResourcePlugin149{}),
+		reflect.TypeOf(composedResourcePlugin150{}),
+		reflect.TypeOf(composedResourcePlugin152{}),
+		reflect.TypeOf(composedResourcePlugin163{}),
+		reflect.TypeOf(composedResourcePlugin165{}),
+		reflect.TypeOf(composedResourcePlugin166{}),
+		reflect.TypeOf(composedResourcePlugin168{}),
+		reflect.TypeOf(composedResourcePlugin177{}),
+		reflect.TypeOf(composedResourcePlugin178{}),
+		reflect.TypeOf(composedResourcePlugin180{}),
+		reflect.TypeOf(composedResourcePlugin195{}),
+		reflect.TypeOf(composedResourcePlugin197{}),
+		reflect.TypeOf(composedResourcePlugin198{}),
+		reflect.TypeOf(composedResourcePlugin200{}),
+		reflect.TypeOf(composedResourcePlugin209{}),
+		reflect.TypeOf(composedResourcePlugin210{}),
+		reflect.TypeOf(composedResourcePlugin212{}),
+		reflect.TypeOf(composedResourcePlugin225{}),
+		reflect.TypeOf(composedResourcePlugin226{}),
+		reflect.TypeOf(composedResourcePlugin228{}),
+		reflect.TypeOf(composedResourcePlugin240{}),
+		reflect.TypeOf(composedResourcePlugin7{}),
+		reflect.TypeOf(composedResourcePlugin9{}),
+		reflect.TypeOf(composedResourcePlugin10{}),
+		reflect.TypeOf(composedResourcePlugin12{}),
+		reflect.TypeOf(composedResourcePlugin19{}),
+		reflect.TypeOf(composedResourcePlugin21{}),
+		reflect.TypeOf(composedResourcePlugin22{}),
+		reflect.TypeOf(composedResourcePlugin24{}),
+		reflect.TypeOf(composedResourcePlugin35{}),
+		reflect.TypeOf(composedResourcePlugin37{}),
+		reflect.TypeOf(composedResourcePlugin38{}),
+		reflect.TypeOf(composedResourcePlugin40{}),
+		reflect.TypeOf(composedResourcePlugin49{}),
+		reflect.TypeOf(composedResourcePlugin50{}),
+		reflect.TypeOf(composedResourcePlugin52{}),
+		reflect.TypeOf(composedResourcePlugin67{}),
+		reflect.TypeOf(composedResourcePlugin69{}),
+		reflect.TypeOf(composedResourcePlugin70{}),
+		reflect.TypeOf(composedResourcePlugin72{}),
+		reflect.TypeOf(composedResourcePlugin81{}),
+		reflect.TypeOf(composedResourcePlugin82{}),
+		reflect.TypeOf(composedResourcePlugin84{}),
+		reflect.TypeOf(composedResourcePlugin97{}),
+		reflect.TypeOf(composedResourcePlugin98{}),
+		reflect.TypeOf(composedResourcePlugin100{}),
+		reflect.TypeOf(composedResourcePlugin112{}),
+		reflect.TypeOf(composedResourcePlugin131{}),
+		reflect.TypeOf(composedResourcePlugin133{}),
+		reflect.TypeOf(composedResourcePlugin134{}),
+		reflect.TypeOf(composedResourcePlugin136{}),
+		reflect.TypeOf(composedResourcePlugin145{}),
+		reflect.TypeOf(composedResourcePlugin146{}),
+		reflect.TypeOf(composedResourcePlugin148{}),
+		reflect.TypeOf(composedResourcePlugin161{}),
+		reflect.TypeOf(composedResourcePlugin162{}),
+		reflect.TypeOf(composedResourcePlugin164{}),
+		reflect.TypeOf(composedResourcePlugin176{}),
+		reflect.TypeOf(composedResourcePlugin193{}),
+		reflect.TypeOf(composedResourcePlugin194{}),
+		reflect.TypeOf(composedResourcePlugin196{}),
+		reflect.TypeOf(composedResourcePlugin208{}),
+		reflect.TypeOf(composedResourcePlugin224{}),
+		reflect.TypeOf(composedResourcePlugin3{}),
+		reflect.TypeOf(composedResourcePlugin5{}),
+		reflect.TypeOf(composedResourcePlugin6{}),
+		reflect.TypeOf(composedResourcePlugin8{}),
+		reflect.TypeOf(composedResourcePlugin17{}),
+		reflect.TypeOf(composedResourcePlugin18{}),
+		reflect.TypeOf(composedResourcePlugin20{}),
+		reflect.TypeOf(composedResourcePlugin33{}),
+		reflect.TypeOf(composedResourcePlugin34{}),
+		reflect.TypeOf(composedResourcePlugin36{}),
+		reflect.TypeOf(composedResourcePlugin48{}),
+		reflect.TypeOf(composedResourcePlugin65{}),
+		reflect.TypeOf(composedResourcePlugin66{}),
+		reflect.TypeOf(composedResourcePlugin68{}),
+		reflect.TypeOf(composedResourcePlugin80{}),
+		reflect.TypeOf(composedResourcePlugin96{}),
+		reflect.TypeOf(composedResourcePlugin129{}),
+		reflect.TypeOf(composedResourcePlugin130{}),
+		reflect.TypeOf(composedResourcePlugin132{}),
+		reflect.TypeOf(composedResourcePlugin144{}),
+		reflect.TypeOf(composedResourcePlugin160{}),
+		reflect.TypeOf(composedResourcePlugin192{}),
+		reflect.TypeOf(composedResourcePlugin1{}),
+		reflect.TypeOf(composedResourcePlugin2{}),
+		reflect.TypeOf(composedResourcePlugin4{}),
+		reflect.TypeOf(composedResourcePlugin16{}),
+		reflect.TypeOf(composedResourcePlugin32{}),
+		reflect.TypeOf(composedResourcePlugin64{}),
+		reflect.TypeOf(composedResourcePlugin128{}),
+	}
+	MapTypes[reflect.ValueOf((*_github_com_slok_terraform_provider_goplugin_pkg_api_v1_DataSourcePlugin)(nil))] = []reflect.Type{
+		reflect.TypeOf(composedDataSourcePlugin15{}),
+		reflect.TypeOf(composedDataSourcePlugin7{}),
+		reflect.TypeOf(composedDataSourcePlugin11{}),
+		reflect.TypeOf(composedDataSourcePlugin13{}),
+		reflect.TypeOf(composedDataSourcePlugin14{}),
+		reflect.TypeOf(composedDataSourcePlugin3{}),
+		reflect.TypeOf(composedDataSourcePlugin5{}),
+		reflect.TypeOf(composedDataSourcePlugin6{}),
+		reflect.TypeOf(composedDataSourcePlugin9{}),
+		reflect.TypeOf(composedDataSourcePlugin10{}),
+		reflect.TypeOf(composedDataSourcePlugin12{}),
+		reflect.TypeOf(composedDataSourcePlugin1{}),
+		reflect.TypeOf(composedDataSourcePlugin2{}),
+		reflect.TypeOf(composedDataSourcePlugin4{}),
+		reflect.TypeOf(composedDataSourcePlugin8{}),
+	}
+}
+
+// composedResourcePlugin255 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin255 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin127 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin127 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin191 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin191 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin223 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin223 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin239 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin239 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin251 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin251 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin253 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin253 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin254 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin254 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin63 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker.
+type composedResourcePlugin63 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin95 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, Closer.
+type composedResourcePlugin95 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin111 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, HealthChecker, Closer.
+type composedResourcePlugin111 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin123 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin123 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin125 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin125 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin126 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin126 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin159 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, InfoProvider.
+type composedResourcePlugin159 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin175 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, HealthChecker, InfoProvider.
+type composedResourcePlugin175 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin187 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin187 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin189 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin189 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin190 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin190 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin207 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, Closer, InfoProvider.
+type composedResourcePlugin207 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin219 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin219 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin221 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin221 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin222 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin222 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin235 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin235 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin237 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin237 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin238 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin238 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin247 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin247 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin249 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin249 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin250 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin250 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin252 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin252 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin31 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator.
+type composedResourcePlugin31 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin47 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, HealthChecker.
+type composedResourcePlugin47 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin59 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, AttributesComparator, HealthChecker.
+type composedResourcePlugin59 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin61 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker.
+type composedResourcePlugin61 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin62 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker.
+type composedResourcePlugin62 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin79 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, Closer.
+type composedResourcePlugin79 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin91 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, AttributesComparator, Closer.
+type composedResourcePlugin91 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin93 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, AttributesComparator, Closer.
+type composedResourcePlugin93 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin94 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, Closer.
+type composedResourcePlugin94 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin107 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, HealthChecker, Closer.
+type composedResourcePlugin107 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin109 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, HealthChecker, Closer.
+type composedResourcePlugin109 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin110 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, HealthChecker, Closer.
+type composedResourcePlugin110 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin119 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin119 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin121 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin121 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin122 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin122 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin124 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin124 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin143 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader, InfoProvider.
+type composedResourcePlugin143 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin155 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, AttributesComparator, InfoProvider.
+type composedResourcePlugin155 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin157 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, AttributesComparator, InfoProvider.
+type composedResourcePlugin157 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin158 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator, InfoProvider.
+type composedResourcePlugin158 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin171 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, HealthChecker, InfoProvider.
+type composedResourcePlugin171 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin173 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, HealthChecker, InfoProvider.
+type composedResourcePlugin173 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin174 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, HealthChecker, InfoProvider.
+type composedResourcePlugin174 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin183 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin183 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin185 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin185 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin186 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin186 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin188 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin188 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin203 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, Closer, InfoProvider.
+type composedResourcePlugin203 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin205 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, Closer, InfoProvider.
+type composedResourcePlugin205 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin206 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, Closer, InfoProvider.
+type composedResourcePlugin206 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin215 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin215 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin217 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin217 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin218 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin218 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin220 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin220 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin231 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin231 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin233 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin233 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin234 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin234 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin236 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin236 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin243 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin243 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin245 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin245 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin246 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin246 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin248 is the composed wrapper of ResourcePlugin, AttributesUpgrader, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin248 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin15 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesUpgrader.
+type composedResourcePlugin15 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin27 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, AttributesComparator.
+type composedResourcePlugin27 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin29 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, AttributesComparator.
+type composedResourcePlugin29 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin30 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, AttributesComparator.
+type composedResourcePlugin30 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin43 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, HealthChecker.
+type composedResourcePlugin43 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin45 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, HealthChecker.
+type composedResourcePlugin45 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin46 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, HealthChecker.
+type composedResourcePlugin46 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin55 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesComparator, HealthChecker.
+type composedResourcePlugin55 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin57 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, AttributesComparator, HealthChecker.
+type composedResourcePlugin57 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin58 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, AttributesComparator, HealthChecker.
+type composedResourcePlugin58 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin60 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, AttributesComparator, HealthChecker.
+type composedResourcePlugin60 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin75 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, Closer.
+type composedResourcePlugin75 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin77 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, Closer.
+type composedResourcePlugin77 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin78 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, Closer.
+type composedResourcePlugin78 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin87 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesComparator, Closer.
+type composedResourcePlugin87 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin89 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, AttributesComparator, Closer.
+type composedResourcePlugin89 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin90 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, AttributesComparator, Closer.
+type composedResourcePlugin90 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin92 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, AttributesComparator, Closer.
+type composedResourcePlugin92 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin103 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, HealthChecker, Closer.
+type composedResourcePlugin103 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin105 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, HealthChecker, Closer.
+type composedResourcePlugin105 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin106 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, HealthChecker, Closer.
+type composedResourcePlugin106 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin108 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, HealthChecker, Closer.
+type composedResourcePlugin108 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin115 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin115 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin117 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin117 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin118 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin118 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin120 is the composed wrapper of ResourcePlugin, AttributesUpgrader, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin120 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin139 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader, InfoProvider.
+type composedResourcePlugin139 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin141 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader, InfoProvider.
+type composedResourcePlugin141 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin142 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader, InfoProvider.
+type composedResourcePlugin142 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin151 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesComparator, InfoProvider.
+type composedResourcePlugin151 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin153 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, AttributesComparator, InfoProvider.
+type composedResourcePlugin153 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin154 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, AttributesComparator, InfoProvider.
+type composedResourcePlugin154 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin156 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, AttributesComparator, InfoProvider.
+type composedResourcePlugin156 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin167 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, HealthChecker, InfoProvider.
+type composedResourcePlugin167 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin169 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, HealthChecker, InfoProvider.
+type composedResourcePlugin169 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin170 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, HealthChecker, InfoProvider.
+type composedResourcePlugin170 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin172 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, HealthChecker, InfoProvider.
+type composedResourcePlugin172 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin179 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin179 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin181 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin181 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin182 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin182 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin184 is the composed wrapper of ResourcePlugin, AttributesUpgrader, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin184 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin199 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, Closer, InfoProvider.
+type composedResourcePlugin199 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin201 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, Closer, InfoProvider.
+type composedResourcePlugin201 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin202 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, Closer, InfoProvider.
+type composedResourcePlugin202 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin204 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, Closer, InfoProvider.
+type composedResourcePlugin204 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin211 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin211 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin213 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin213 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin214 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin214 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin216 is the composed wrapper of ResourcePlugin, AttributesUpgrader, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin216 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin227 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin227 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin229 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin229 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin230 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin230 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin232 is the composed wrapper of ResourcePlugin, AttributesUpgrader, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin232 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin241 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin241 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin242 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin242 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin244 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin244 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin11 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesUpgrader.
+type composedResourcePlugin11 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin13 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesUpgrader.
+type composedResourcePlugin13 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin14 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesUpgrader.
+type composedResourcePlugin14 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin23 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, AttributesComparator.
+type composedResourcePlugin23 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin25 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, AttributesComparator.
+type composedResourcePlugin25 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin26 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, AttributesComparator.
+type composedResourcePlugin26 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin28 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, AttributesComparator.
+type composedResourcePlugin28 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin39 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, HealthChecker.
+type composedResourcePlugin39 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin41 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, HealthChecker.
+type composedResourcePlugin41 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin42 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, HealthChecker.
+type composedResourcePlugin42 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin44 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, HealthChecker.
+type composedResourcePlugin44 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin51 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesComparator, HealthChecker.
+type composedResourcePlugin51 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin53 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesComparator, HealthChecker.
+type composedResourcePlugin53 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin54 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesComparator, HealthChecker.
+type composedResourcePlugin54 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin56 is the composed wrapper of ResourcePlugin, AttributesUpgrader, AttributesComparator, HealthChecker.
+type composedResourcePlugin56 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin71 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, Closer.
+type composedResourcePlugin71 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin73 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, Closer.
+type composedResourcePlugin73 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin74 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, Closer.
+type composedResourcePlugin74 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin76 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, Closer.
+type composedResourcePlugin76 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin83 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesComparator, Closer.
+type composedResourcePlugin83 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin85 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesComparator, Closer.
+type composedResourcePlugin85 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin86 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesComparator, Closer.
+type composedResourcePlugin86 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin88 is the composed wrapper of ResourcePlugin, AttributesUpgrader, AttributesComparator, Closer.
+type composedResourcePlugin88 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin99 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, HealthChecker, Closer.
+type composedResourcePlugin99 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin101 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, HealthChecker, Closer.
+type composedResourcePlugin101 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin102 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, HealthChecker, Closer.
+type composedResourcePlugin102 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin104 is the composed wrapper of ResourcePlugin, AttributesUpgrader, HealthChecker, Closer.
+type composedResourcePlugin104 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin113 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin113 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin114 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin114 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin116 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin116 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin135 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter, InfoProvider.
+type composedResourcePlugin135 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin137 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader, InfoProvider.
+type composedResourcePlugin137 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin138 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader, InfoProvider.
+type composedResourcePlugin138 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin140 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader, InfoProvider.
+type composedResourcePlugin140 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin147 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesComparator, InfoProvider.
+type composedResourcePlugin147 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin149 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesComparator, InfoProvider.
+type composedResourcePlugin149 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin150 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesComparator, InfoProvider.
+type composedResourcePlugin150 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin152 is the composed wrapper of ResourcePlugin, AttributesUpgrader, AttributesComparator, InfoProvider.
+type composedResourcePlugin152 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin163 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, HealthChecker, InfoProvider.
+type composedResourcePlugin163 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin165 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, HealthChecker, InfoProvider.
+type composedResourcePlugin165 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin166 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, HealthChecker, InfoProvider.
+type composedResourcePlugin166 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin168 is the composed wrapper of ResourcePlugin, AttributesUpgrader, HealthChecker, InfoProvider.
+type composedResourcePlugin168 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin177 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin177 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin178 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin178 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin180 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin180 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin195 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, Closer, InfoProvider.
+type composedResourcePlugin195 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin197 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, Closer, InfoProvider.
+type composedResourcePlugin197 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin198 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, Closer, InfoProvider.
+type composedResourcePlugin198 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin200 is the composed wrapper of ResourcePlugin, AttributesUpgrader, Closer, InfoProvider.
+type composedResourcePlugin200 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin209 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin209 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin210 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin210 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin212 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin212 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin225 is the composed wrapper of ResourcePlugin, ResourcePlanner, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin225 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin226 is the composed wrapper of ResourcePlugin, AttributesValidator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin226 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin228 is the composed wrapper of ResourcePlugin, ResourceImporter, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin228 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin240 is the composed wrapper of ResourcePlugin, AttributesComparator, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin240 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin7 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, ResourceImporter.
+type composedResourcePlugin7 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin9 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesUpgrader.
+type composedResourcePlugin9 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin10 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesUpgrader.
+type composedResourcePlugin10 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+	WValidateAttributes
+}
+
+// composedResourcePlugin12 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesUpgrader.
+type composedResourcePlugin12 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin19 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, AttributesComparator.
+type composedResourcePlugin19 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin21 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, AttributesComparator.
+type composedResourcePlugin21 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin22 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, AttributesComparator.
+type composedResourcePlugin22 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin24 is the composed wrapper of ResourcePlugin, AttributesUpgrader, AttributesComparator.
+type composedResourcePlugin24 struct {
+	IValue interface{}
+	WAttributesEqual
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin35 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, HealthChecker.
+type composedResourcePlugin35 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin37 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, HealthChecker.
+type composedResourcePlugin37 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin38 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, HealthChecker.
+type composedResourcePlugin38 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin40 is the composed wrapper of ResourcePlugin, AttributesUpgrader, HealthChecker.
+type composedResourcePlugin40 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin49 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesComparator, HealthChecker.
+type composedResourcePlugin49 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin50 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesComparator, HealthChecker.
+type composedResourcePlugin50 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin52 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesComparator, HealthChecker.
+type composedResourcePlugin52 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin67 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, Closer.
+type composedResourcePlugin67 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin69 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, Closer.
+type composedResourcePlugin69 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin70 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, Closer.
+type composedResourcePlugin70 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin72 is the composed wrapper of ResourcePlugin, AttributesUpgrader, Closer.
+type composedResourcePlugin72 struct {
+	IValue interface{}
+	WAttributesVersion
+	WClose
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin81 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesComparator, Closer.
+type composedResourcePlugin81 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin82 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesComparator, Closer.
+type composedResourcePlugin82 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin84 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesComparator, Closer.
+type composedResourcePlugin84 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin97 is the composed wrapper of ResourcePlugin, ResourcePlanner, HealthChecker, Closer.
+type composedResourcePlugin97 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin98 is the composed wrapper of ResourcePlugin, AttributesValidator, HealthChecker, Closer.
+type composedResourcePlugin98 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin100 is the composed wrapper of ResourcePlugin, ResourceImporter, HealthChecker, Closer.
+type composedResourcePlugin100 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin112 is the composed wrapper of ResourcePlugin, AttributesComparator, HealthChecker, Closer.
+type composedResourcePlugin112 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin131 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator, InfoProvider.
+type composedResourcePlugin131 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin133 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter, InfoProvider.
+type composedResourcePlugin133 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin134 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter, InfoProvider.
+type composedResourcePlugin134 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin136 is the composed wrapper of ResourcePlugin, AttributesUpgrader, InfoProvider.
+type composedResourcePlugin136 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin145 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesComparator, InfoProvider.
+type composedResourcePlugin145 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin146 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesComparator, InfoProvider.
+type composedResourcePlugin146 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin148 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesComparator, InfoProvider.
+type composedResourcePlugin148 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin161 is the composed wrapper of ResourcePlugin, ResourcePlanner, HealthChecker, InfoProvider.
+type composedResourcePlugin161 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin162 is the composed wrapper of ResourcePlugin, AttributesValidator, HealthChecker, InfoProvider.
+type composedResourcePlugin162 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin164 is the composed wrapper of ResourcePlugin, ResourceImporter, HealthChecker, InfoProvider.
+type composedResourcePlugin164 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin176 is the composed wrapper of ResourcePlugin, AttributesComparator, HealthChecker, InfoProvider.
+type composedResourcePlugin176 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin193 is the composed wrapper of ResourcePlugin, ResourcePlanner, Closer, InfoProvider.
+type composedResourcePlugin193 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin194 is the composed wrapper of ResourcePlugin, AttributesValidator, Closer, InfoProvider.
+type composedResourcePlugin194 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin196 is the composed wrapper of ResourcePlugin, ResourceImporter, Closer, InfoProvider.
+type composedResourcePlugin196 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin208 is the composed wrapper of ResourcePlugin, AttributesComparator, Closer, InfoProvider.
+type composedResourcePlugin208 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin224 is the composed wrapper of ResourcePlugin, HealthChecker, Closer, InfoProvider.
+type composedResourcePlugin224 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin3 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesValidator.
+type composedResourcePlugin3 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin5 is the composed wrapper of ResourcePlugin, ResourcePlanner, ResourceImporter.
+type composedResourcePlugin5 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin6 is the composed wrapper of ResourcePlugin, AttributesValidator, ResourceImporter.
+type composedResourcePlugin6 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin8 is the composed wrapper of ResourcePlugin, AttributesUpgrader.
+type composedResourcePlugin8 struct {
+	IValue interface{}
+	WAttributesVersion
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+	WUpgradeAttributes
+}
+
+// composedResourcePlugin17 is the composed wrapper of ResourcePlugin, ResourcePlanner, AttributesComparator.
+type composedResourcePlugin17 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin18 is the composed wrapper of ResourcePlugin, AttributesValidator, AttributesComparator.
+type composedResourcePlugin18 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin20 is the composed wrapper of ResourcePlugin, ResourceImporter, AttributesComparator.
+type composedResourcePlugin20 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin33 is the composed wrapper of ResourcePlugin, ResourcePlanner, HealthChecker.
+type composedResourcePlugin33 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin34 is the composed wrapper of ResourcePlugin, AttributesValidator, HealthChecker.
+type composedResourcePlugin34 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin36 is the composed wrapper of ResourcePlugin, ResourceImporter, HealthChecker.
+type composedResourcePlugin36 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WImportResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin48 is the composed wrapper of ResourcePlugin, AttributesComparator, HealthChecker.
+type composedResourcePlugin48 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin65 is the composed wrapper of ResourcePlugin, ResourcePlanner, Closer.
+type composedResourcePlugin65 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin66 is the composed wrapper of ResourcePlugin, AttributesValidator, Closer.
+type composedResourcePlugin66 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin68 is the composed wrapper of ResourcePlugin, ResourceImporter, Closer.
+type composedResourcePlugin68 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin80 is the composed wrapper of ResourcePlugin, AttributesComparator, Closer.
+type composedResourcePlugin80 struct {
+	IValue interface{}
+	WAttributesEqual
+	WClose
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin96 is the composed wrapper of ResourcePlugin, HealthChecker, Closer.
+type composedResourcePlugin96 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin129 is the composed wrapper of ResourcePlugin, ResourcePlanner, InfoProvider.
+type composedResourcePlugin129 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin130 is the composed wrapper of ResourcePlugin, AttributesValidator, InfoProvider.
+type composedResourcePlugin130 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin132 is the composed wrapper of ResourcePlugin, ResourceImporter, InfoProvider.
+type composedResourcePlugin132 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin144 is the composed wrapper of ResourcePlugin, AttributesComparator, InfoProvider.
+type composedResourcePlugin144 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin160 is the composed wrapper of ResourcePlugin, HealthChecker, InfoProvider.
+type composedResourcePlugin160 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin192 is the composed wrapper of ResourcePlugin, Closer, InfoProvider.
+type composedResourcePlugin192 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin1 is the composed wrapper of ResourcePlugin, ResourcePlanner.
+type composedResourcePlugin1 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WPlanResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin2 is the composed wrapper of ResourcePlugin, AttributesValidator.
+type composedResourcePlugin2 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+	WValidateAttributes
+}
+
+// composedResourcePlugin4 is the composed wrapper of ResourcePlugin, ResourceImporter.
+type composedResourcePlugin4 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WImportResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin16 is the composed wrapper of ResourcePlugin, AttributesComparator.
+type composedResourcePlugin16 struct {
+	IValue interface{}
+	WAttributesEqual
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin32 is the composed wrapper of ResourcePlugin, HealthChecker.
+type composedResourcePlugin32 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WHealthCheck
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin64 is the composed wrapper of ResourcePlugin, Closer.
+type composedResourcePlugin64 struct {
+	IValue interface{}
+	WClose
+	WCreateResource
+	WDeleteResource
+	WReadResource
+	WUpdateResource
+}
+
+// composedResourcePlugin128 is the composed wrapper of ResourcePlugin, InfoProvider.
+type composedResourcePlugin128 struct {
+	IValue interface{}
+	WCreateResource
+	WDeleteResource
+	WInfo
+	WReadResource
+	WUpdateResource
+}
+
+// composedDataSourcePlugin15 is the composed wrapper of DataSourcePlugin, AttributesValidator, HealthChecker, Closer, InfoProvider.
+type composedDataSourcePlugin15 struct {
+	IValue interface{}
+	WClose
+	WHealthCheck
+	WInfo
+	WReadDataSource
+	WValidateAttributes
+}
+
+// composedDataSourcePlugin7 is the composed wrapper of DataSourcePlugin, AttributesValidator, HealthChecker, Closer.
+type composedDataSourcePlugin7 struct {
+	IValue interface{}
+	WClose
+	WHealthCheck
+	WReadDataSource
+	WValidateAttributes
+}
+
+// composedDataSourcePlugin11 is the composed wrapper of DataSourcePlugin, AttributesValidator, HealthChecker, InfoProvider.
+type composedDataSourcePlugin11 struct {
+	IValue interface{}
+	WHealthCheck
+	WInfo
+	WReadDataSource
+	WValidateAttributes
+}
+
+// composedDataSourcePlugin13 is the composed wrapper of DataSourcePlugin, AttributesValidator, Closer, InfoProvider.
+type composedDataSourcePlugin13 struct {
+	IValue interface{}
+	WClose
+	WInfo
+	WReadDataSource
+	WValidateAttributes
+}
+
+// composedDataSourcePlugin14 is the composed wrapper of DataSourcePlugin, HealthChecker, Closer, InfoProvider.
+type composedDataSourcePlugin14 struct {
+	IValue interface{}
+	WClose
+	WHealthCheck
+	WInfo
+	WReadDataSource
+}
+
+// composedDataSourcePlugin3 is the composed wrapper of DataSourcePlugin, AttributesValidator, HealthChecker.
+type composedDataSourcePlugin3 struct {
+	IValue interface{}
+	WHealthCheck
+	WReadDataSource
+	WValidateAttributes
+}
+
+// composedDataSourcePlugin5 is the composed wrapper of DataSourcePlugin, AttributesValidator, Closer.
+type composedDataSourcePlugin5 struct {
+	IValue interface{}
+	WClose
+	WReadDataSource
+	WValidateAttributes
+}
+
+// composedDataSourcePlugin6 is the composed wrapper of DataSourcePlugin, HealthChecker, Closer.
+type composedDataSourcePlugin6 struct {
+	IValue interface{}
+	WClose
+	WHealthCheck
+	WReadDataSource
+}
+
+// composedDataSourcePlugin9 is the composed wrapper of DataSourcePlugin, AttributesValidator, InfoProvider.
+type composedDataSourcePlugin9 struct {
+	IValue interface{}
+	WInfo
+	WReadDataSource
+	WValidateAttributes
+}
+
+// composedDataSourcePlugin10 is the composed wrapper of DataSourcePlugin, HealthChecker, InfoProvider.
+type composedDataSourcePlugin10 struct {
+	IValue interface{}
+	WHealthCheck
+	WInfo
+	WReadDataSource
+}
+
+// composedDataSourcePlugin12 is the composed wrapper of DataSourcePlugin, Closer, InfoProvider.
+type composedDataSourcePlugin12 struct {
+	IValue interface{}
+	WClose
+	WInfo
+	WReadDataSource
+}
+
+// composedDataSourcePlugin1 is the composed wrapper of DataSourcePlugin, AttributesValidator.
+type composedDataSourcePlugin1 struct {
+	IValue interface{}
+	WReadDataSource
+	WValidateAttributes
+}
+
+// composedDataSourcePlugin2 is the composed wrapper of DataSourcePlugin, HealthChecker.
+type composedDataSourcePlugin2 struct {
+	IValue interface{}
+	WHealthCheck
+	WReadDataSource
+}
+
+// composedDataSourcePlugin4 is the composed wrapper of DataSourcePlugin, Closer.
+type composedDataSourcePlugin4 struct {
+	IValue interface{}
+	WClose
+	WReadDataSource
+}
+
+// composedDataSourcePlugin8 is the composed wrapper of DataSourcePlugin, InfoProvider.
+type composedDataSourcePlugin8 struct {
+	IValue interface{}
+	WInfo
+	WReadDataSource
+}
@@ -5,6 +5,19 @@ import (
 )
 
 //go:generate yaegi extract --name yaegicustom  github.com/slok/terraform-provider-goplugin/pkg/api/v1
+//go:generate go run ./composedgen -out wrapper_composed.go
 
 // Symbols variable stores the map of custom symbols per package.
 var Symbols = map[string]map[string]reflect.Value{}
+
+// MapTypes variable stores the composed interface wrappers that Yaegi will use when an interpreted
+// type is converted to one of our interfaces, this way optional interfaces implemented by the plugins
+// are not lost when crossing the interpreter boundary. The engine wraps the plugins with the capabilities
+// detected from these wrappers (check `v1.Supports`).
+var MapTypes = map[reflect.Value][]reflect.Type{}
+
+func init() {
+	Symbols["."] = map[string]reflect.Value{
+		"MapTypes": reflect.ValueOf(MapTypes),
+	}
+}
@@ -0,0 +1,41 @@
+package attributeutils
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MustDuration is a validator that will validate that a string is a positive Go duration (e.g `30s`, `5m`, `1h30m`).
+const MustDuration = mustDuration(false)
+
+type mustDuration bool
+
+func (m mustDuration) Description(ctx context.Context) string         { return "" }
+func (m mustDuration) MarkdownDescription(ctx context.Context) string { return m.Description(ctx) }
+
+func (m mustDuration) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var s types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &s)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if s.IsUnknown() || s.IsNull() {
+		return
+	}
+
+	d, err := time.ParseDuration(s.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(req.AttributePath.String(), "Attribute must be a duration (e.g: `30s`, `5m`, `1h30m`)")
+		return
+	}
+
+	if d <= 0 {
+		resp.Diagnostics.AddError(req.AttributePath.String(), "Duration must be positive")
+		return
+	}
+}
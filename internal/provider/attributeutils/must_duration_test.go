@@ -0,0 +1,76 @@
+package attributeutils_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/slok/terraform-provider-goplugin/internal/provider/attributeutils"
+)
+
+func TestMustDuration(t *testing.T) {
+	tests := map[string]struct {
+		value  string
+		expErr bool
+	}{
+		"Empty string should fail.": {
+			value:  ``,
+			expErr: true,
+		},
+
+		"Valid duration should not fail.": {
+			value:  `5m`,
+			expErr: false,
+		},
+
+		"Valid composed duration should not fail.": {
+			value:  `1h30m15s`,
+			expErr: false,
+		},
+
+		"Duration without unit should fail.": {
+			value:  `30`,
+			expErr: true,
+		},
+
+		"Zero duration should fail.": {
+			value:  `0s`,
+			expErr: true,
+		},
+
+		"Negative duration should fail.": {
+			value:  `-5m`,
+			expErr: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			require := require.New(t)
+
+			val, err := types.StringType.ValueFromTerraform(context.TODO(), tftypes.NewValue(tftypes.String, test.value))
+			require.NoError(err)
+
+			request := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: val,
+			}
+			response := &tfsdk.ValidateAttributeResponse{}
+
+			attributeutils.MustDuration.Validate(context.TODO(), request, response)
+
+			if test.expErr {
+				assert.True(response.Diagnostics.HasError())
+			} else {
+				assert.False(response.Diagnostics.HasError())
+			}
+		})
+	}
+}
@@ -30,12 +30,13 @@ func (s suppressEquivalentJSON) Modify(ctx context.Context, req tfsdk.ModifyAttr
 	config := req.AttributeConfig.(types.String)
 	state := req.AttributeState.(types.String)
 
-	if s.isEqualJSON(config.ValueString(), state.ValueString()) {
+	if IsEquivalentJSON(config.ValueString(), state.ValueString()) {
 		resp.AttributePlan = req.AttributeState
 	}
 }
 
-func (s suppressEquivalentJSON) isEqualJSON(old, new string) bool {
+// IsEquivalentJSON returns true when 2 JSON are semantically equivalent (e.g map keys order, json format...).
+func IsEquivalentJSON(old, new string) bool {
 	ob := bytes.NewBufferString("")
 	if err := json.Compact(ob, []byte(old)); err != nil {
 		return false
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"os"
 	"strconv"
 	"time"
 
@@ -13,7 +14,6 @@ import (
 
 	"github.com/slok/terraform-provider-goplugin/internal/provider/attributeutils"
 	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
-	v1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
 )
 
 func newDataSourcePluginV1() datasource.DataSource {
@@ -21,7 +21,9 @@ func newDataSourcePluginV1() datasource.DataSource {
 }
 
 type dataSourcePluginV1 struct {
-	plugins map[string]apiv1.DataSourcePlugin
+	providerVersion  string
+	plugins          map[string]apiv1.DataSourcePlugin
+	executionConfigs map[string]pluginV1ExecutionConfig
 }
 
 func (d *dataSourcePluginV1) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -56,6 +58,18 @@ Check [examples](https://github.com/slok/terraform-provider-goplugin/tree/main/e
 				Type:        types.StringType,
 				Computed:    true,
 			},
+			"timeouts": {
+				Optional:    true,
+				Description: `Timeouts of the plugin operations (e.g: ` + "`30s`, `5m`, `1h`" + `), if not set, the plugin default timeouts configured on the provider will be used.`,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"read": {
+						Optional:    true,
+						Description: `Timeout of the read operation, also used by the validation operation.`,
+						Validators:  []tfsdk.AttributeValidator{attributeutils.MustDuration},
+						Type:        types.StringType,
+					},
+				}),
+			},
 			"id": {
 				Description: `Not used (Used internally by the provider and Terraform), can be ignored.`,
 				Computed:    true,
@@ -66,7 +80,7 @@ Check [examples](https://github.com/slok/terraform-provider-goplugin/tree/main/e
 }
 
 func (d *dataSourcePluginV1) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_plugin_v1"
+	resp.TypeName = pluginV1TypeName
 }
 
 func (d *dataSourcePluginV1) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -85,6 +99,35 @@ func (d *dataSourcePluginV1) Configure(ctx context.Context, req datasource.Confi
 	}
 
 	d.plugins = dsd.plugins
+	d.executionConfigs = dsd.executionConfigs
+	d.providerVersion = dsd.providerVersion
+}
+
+func (d *dataSourcePluginV1) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	// Retrieve values from config.
+	var tfConfig DataSourcePluginV1
+	diags := req.Config.Get(ctx, &tfConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We can only validate when we know the values.
+	if tfConfig.PluginID.IsUnknown() || tfConfig.PluginID.IsNull() || tfConfig.Attributes.IsUnknown() || tfConfig.Attributes.IsNull() {
+		return
+	}
+
+	// Plugins are not loaded if the provider is not configured (e.g: `terraform validate`), missing plugins will be
+	// reported when executing the plugin operation.
+	plugin, ok := d.plugins[tfConfig.PluginID.ValueString()]
+	if !ok {
+		return
+	}
+
+	ctx = d.pluginOperationContext(ctx, tfConfig.PluginID.ValueString(), apiv1.OperationValidate, "")
+	executionConfig := d.executionConfigs[tfConfig.PluginID.ValueString()]
+	timeout := operationTimeout(tfConfig.Timeouts.readTimeout(), executionConfig.timeouts.read)
+	resp.Diagnostics.Append(validatePluginAttributes(ctx, plugin, timeout, executionConfig.retry, tfConfig.Attributes.ValueString())...)
 }
 
 func (d *dataSourcePluginV1) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -104,11 +147,21 @@ func (d *dataSourcePluginV1) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	// Execute plugin.
-	pluginResp, err := plugin.ReadDataSource(ctx, v1.ReadDataSourceRequest{
-		Attributes: tfConfig.Attributes.ValueString(),
+	ctx = d.pluginOperationContext(ctx, tfConfig.PluginID.ValueString(), apiv1.OperationRead, "")
+	executionConfig := d.executionConfigs[tfConfig.PluginID.ValueString()]
+	timeout := operationTimeout(tfConfig.Timeouts.readTimeout(), executionConfig.timeouts.read)
+	pluginResp, err := runPluginOperation(ctx, timeout, executionConfig.retry, func(ctx context.Context) (*apiv1.ReadDataSourceResponse, error) {
+		return plugin.ReadDataSource(ctx, apiv1.ReadDataSourceRequest{
+			Attributes: tfConfig.Attributes.ValueString(),
+		})
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error executing plugin", "Plugin execution end in error: "+err.Error())
+		resp.Diagnostics.Append(pluginErrorDiagnostic(err))
+		return
+	}
+
+	resp.Diagnostics.Append(pluginDiagnostics(pluginResp.Diagnostics)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -124,3 +177,15 @@ func (d *dataSourcePluginV1) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 }
+
+// pluginOperationContext returns the context for the plugin operations of the data source.
+func (d *dataSourcePluginV1) pluginOperationContext(ctx context.Context, pluginID, operation, resourceID string) context.Context {
+	return pluginOperationContext(ctx, apiv1.RequestInfo{
+		PluginID:        pluginID,
+		Operation:       operation,
+		ResourceType:    pluginV1TypeName,
+		ResourceID:      resourceID,
+		Workspace:       os.Getenv("TF_WORKSPACE"),
+		ProviderVersion: d.providerVersion,
+	})
+}
@@ -2,35 +2,16 @@ package provider_test
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-const dataSourceProviderConfigFmt = `
-terraform {
-  required_providers {
-    goplugin = {
-      source = "goplugin"
-    }
-  }
-}
-
-provider goplugin { 
-  data_source_plugins_v1 = {
-    "fake": {
-      source_code = {
-		dir = "testdata/fake_data_source"
-	  }
-      configuration =  jsonencode({})
-    }
-  }
-}
-
-%s
-`
-
 // TestAccDataSourcePlugingV1 will check a data source plugin is executed correctly.
 func TestAccDataSourcePlugingV1(t *testing.T) {
 	tests := map[string]struct {
@@ -58,6 +39,18 @@ data "goplugin_plugin_v1" "test" {
 			expErr: regexp.MustCompile(`Attribute must be JSON object`),
 		},
 
+		"A plugin failing the attributes validation should fail.": {
+			config: `
+data "goplugin_plugin_v1" "test" {
+  plugin_id = "fake"
+  attributes = jsonencode({
+    invalid = "true"
+  })
+}
+`,
+			expErr: regexp.MustCompile(`Attribute "invalid": Attribute not supported`),
+		},
+
 		"A correct configuration should execute correctly.": {
 			config: `
 data "goplugin_plugin_v1" "test" {
@@ -70,6 +63,45 @@ data "goplugin_plugin_v1" "test" {
 `,
 			expResult: `{"something":"otherthing","test1":"test2"}`,
 		},
+
+		"A plugin not ending in time should fail.": {
+			config: `
+data "goplugin_plugin_v1" "test" {
+  plugin_id = "fake"
+  attributes = jsonencode({
+    sleep = "10s"
+  })
+  timeouts = {
+    read = "100ms"
+  }
+}
+`,
+			expErr: regexp.MustCompile(`Plugin operation timed out`),
+		},
+
+		"A plugin failing with retryable errors should be retried.": {
+			config: `
+data "goplugin_plugin_v1" "test" {
+  plugin_id = "fake"
+  attributes = jsonencode({
+    retryable_failures = 2
+  })
+}
+`,
+			expResult: `{"retryable_failures":2}`,
+		},
+
+		"A plugin failing with retryable errors more than the max attempts should fail.": {
+			config: `
+data "goplugin_plugin_v1" "test" {
+  plugin_id = "fake"
+  attributes = jsonencode({
+    retryable_failures = 5
+  })
+}
+`,
+			expErr: regexp.MustCompile(`data source not available yet \(after 3 attempts\)`),
+		},
 	}
 
 	for name, test := range tests {
@@ -83,7 +115,11 @@ data "goplugin_plugin_v1" "test" {
 			}
 
 			// Assemble our Terraform config code.
-			config := fmt.Sprintf(dataSourceProviderConfigFmt, test.config)
+			config := testAccFakeDataSourceConfig(`
+      retry = {
+        max_attempts = 3
+        initial_backoff = "10ms"
+      }`, test.config)
 
 			// Execute test.
 			resource.Test(t, resource.TestCase{
@@ -100,3 +136,364 @@ data "goplugin_plugin_v1" "test" {
 		})
 	}
 }
+
+// TestAccDataSourcePlugingV1HealthCheck will check a data source plugin health is checked when configuring the provider.
+func TestAccDataSourcePlugingV1HealthCheck(t *testing.T) {
+	config := testAccProviderConfig(`
+  data_source_plugins_v1 = {
+    "fake": {
+      source_code = {
+        dir = "testdata/fake_data_source"
+      }
+      configuration = jsonencode({
+        unhealthy = true
+      })
+    }
+  }
+`, `
+data "goplugin_plugin_v1" "test" {
+  plugin_id = "fake"
+  attributes = jsonencode({})
+}
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Plugin "fake" is not healthy: invalid credentials`),
+			},
+		},
+	})
+}
+
+// TestAccDataSourcePlugingV1Module will check a data source plugin registered by a plugin module is executed correctly.
+func TestAccDataSourcePlugingV1Module(t *testing.T) {
+	config := testAccProviderConfig(testAccPluginV1Attributes("plugin_modules_v1", "module", "testdata/fake_data_source", ""), `
+data "goplugin_plugin_v1" "test" {
+  plugin_id = "module.fake"
+  attributes = jsonencode({
+    test1 = "test2"
+  })
+}
+
+data "goplugin_plugins" "test" {}
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.goplugin_plugin_v1.test", "result", `{"test1":"test2"}`),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.#", "1"),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.0.plugin_id", "module.fake"),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.0.factory_name", "Plugins.fake"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccDataSourcePlugingV1LoadErrors will check all the plugin loading errors are returned at once.
+func TestAccDataSourcePlugingV1LoadErrors(t *testing.T) {
+	config := testAccProviderConfig(`
+  plugins_load_concurrency = 2
+
+  data_source_plugins_v1 = {
+    "fake": {
+      source_code = {
+        dir = "testdata/fake_data_source"
+      }
+      configuration = jsonencode({})
+    }
+    "missing1": {
+      source_code = {
+        dir = "testdata/missing1"
+      }
+      configuration = jsonencode({})
+    }
+    "missing2": {
+      source_code = {
+        dir = "testdata/missing2"
+      }
+      configuration = jsonencode({})
+    }
+  }
+`, `
+data "goplugin_plugin_v1" "test" {
+  plugin_id = "fake"
+  attributes = jsonencode({})
+}
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`(?s)Could not load data source plugin "missing1".*Could not load data source plugin "missing2"`),
+			},
+		},
+	})
+}
+
+// TestAccDataSourcePlugingV1Sandbox will check a data source plugin importing forbidden packages is not loaded.
+func TestAccDataSourcePlugingV1Sandbox(t *testing.T) {
+	config := testAccFakeDataSourceConfig(`
+      sandbox = {
+        denied_imports = ["encoding/..."]
+      }`, `
+data "goplugin_plugin_v1" "test" {
+  plugin_id = "fake"
+  attributes = jsonencode({})
+}
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`file imports "encoding/json"`),
+			},
+		},
+	})
+}
+
+// TestAccDataSourcePlugingV1Env will check a data source plugin only has the configured environment.
+func TestAccDataSourcePlugingV1Env(t *testing.T) {
+	t.Setenv("TEST_GOPLUGIN_INHERITED", "inherited")
+	t.Setenv("TEST_NOT_INHERITED", "not-inherited")
+
+	config := testAccFakeDataSourceConfig(`
+      inherit_env = ["TEST_GOPLUGIN_*"]
+      env = {
+        FAKE_TOKEN = "secret"
+      }`, `
+data "goplugin_plugin_v1" "test" {
+  plugin_id = "fake"
+  attributes = jsonencode({
+    env = ["FAKE_TOKEN", "TEST_GOPLUGIN_INHERITED", "TEST_NOT_INHERITED"]
+  })
+}
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.goplugin_plugin_v1.test", "result", `{"FAKE_TOKEN":"secret","TEST_GOPLUGIN_INHERITED":"inherited","TEST_NOT_INHERITED":""}`),
+				),
+			},
+		},
+	})
+}
+
+// TestAccDataSourcePlugingV1AllowedHosts will check a data source plugin can only connect to the allowed hosts.
+func TestAccDataSourcePlugingV1AllowedHosts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { _, _ = w.Write([]byte("ok")) }))
+	defer srv.Close()
+
+	tests := map[string]struct {
+		allowedHosts string
+		expErr       *regexp.Regexp
+	}{
+		"A plugin connecting to an allowed host should connect.": {
+			allowedHosts: `["127.0.0.1"]`,
+		},
+
+		"A plugin connecting to a not allowed host should fail.": {
+			allowedHosts: `["api.github.com"]`,
+			expErr:       regexp.MustCompile(`is not allowed to connect to`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var checks resource.TestCheckFunc
+			if test.expErr == nil {
+				checks = resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.goplugin_plugin_v1.test", "result", "ok"),
+				)
+			}
+
+			config := testAccFakeDataSourceConfig(fmt.Sprintf(`
+      allowed_hosts = %s`, test.allowedHosts), fmt.Sprintf(`
+data "goplugin_plugin_v1" "test" {
+  plugin_id = "fake"
+  attributes = jsonencode({
+    http_get = %q
+  })
+}
+`, srv.URL))
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      config,
+						Check:       checks,
+						ExpectError: test.expErr,
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccDataSourcePlugingV1Filesystem(t *testing.T) {
+	root := t.TempDir()
+	err := os.WriteFile(filepath.Join(root, "file.txt"), []byte("ok"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		readFile string
+		expErr   *regexp.Regexp
+	}{
+		"A plugin reading a file inside the filesystem root should read it.": {
+			readFile: "/file.txt",
+		},
+
+		"A plugin reading a file outside the filesystem root should fail.": {
+			readFile: "../file.txt",
+			expErr:   regexp.MustCompile(`path escapes the root by plugin "fake" filesystem policy`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var checks resource.TestCheckFunc
+			if test.expErr == nil {
+				checks = resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.goplugin_plugin_v1.test", "result", "ok"),
+				)
+			}
+
+			config := testAccFakeDataSourceConfig(fmt.Sprintf(`
+      filesystem = {
+        root      = %q
+        read_only = true
+      }`, root), fmt.Sprintf(`
+data "goplugin_plugin_v1" "test" {
+  plugin_id = "fake"
+  attributes = jsonencode({
+    read_file = %q
+  })
+}
+`, test.readFile))
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      config,
+						Check:       checks,
+						ExpectError: test.expErr,
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccDataSourcePlugingV1Process(t *testing.T) {
+	tests := map[string]struct {
+		execution     string
+		processLimits string
+		attributes    string
+		expPID        func(pid string) error
+		expErr        *regexp.Regexp
+	}{
+		"A plugin with in process execution should be executed in the provider process.": {
+			execution:  "in_process",
+			attributes: `{pid = true}`,
+			expPID: func(pid string) error {
+				if pid != fmt.Sprint(os.Getpid()) {
+					return fmt.Errorf("plugin executed in %q process", pid)
+				}
+				return nil
+			},
+		},
+
+		"A plugin with process execution should be executed in a plugin process.": {
+			execution:  "process",
+			attributes: `{pid = true}`,
+			expPID: func(pid string) error {
+				if pid == fmt.Sprint(os.Getpid()) {
+					return fmt.Errorf("plugin executed in the provider process")
+				}
+				return nil
+			},
+		},
+
+		"A plugin crash with process execution should fail without crashing the provider.": {
+			execution:  "process",
+			attributes: `{crash = true}`,
+			expErr:     regexp.MustCompile(`plugin process crashed`),
+		},
+
+		"A plugin with process limits and in process execution should fail.": {
+			execution:     "in_process",
+			processLimits: `{memory_mb = 512}`,
+			attributes:    `{pid = true}`,
+			expErr:        regexp.MustCompile(`process_limits require process execution`),
+		},
+
+		"A plugin with an invalid execution should fail.": {
+			execution:  "wrong",
+			attributes: `{pid = true}`,
+			expErr:     regexp.MustCompile(`unknown "wrong" execution mode`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var checks resource.TestCheckFunc
+			if test.expErr == nil {
+				checks = resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.goplugin_plugin_v1.test", "result", test.expPID),
+				)
+			}
+
+			processLimits := "null"
+			if test.processLimits != "" {
+				processLimits = test.processLimits
+			}
+
+			config := testAccFakeDataSourceConfig(fmt.Sprintf(`
+      execution      = %q
+      process_limits = %s`, test.execution, processLimits), fmt.Sprintf(`
+data "goplugin_plugin_v1" "test" {
+  plugin_id  = "fake"
+  attributes = jsonencode(%s)
+}
+`, test.attributes))
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      config,
+						Check:       checks,
+						ExpectError: test.expErr,
+					},
+				},
+			})
+		})
+	}
+}
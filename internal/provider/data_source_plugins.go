@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+// Plugin kinds.
+const (
+	pluginKindResource   = "resource"
+	pluginKindDataSource = "data_source"
+)
+
+// Plugin source code types.
+const (
+	pluginSourceTypeDir = "dir"
+	pluginSourceTypeGit = "git"
+)
+
+// pluginV1Metadata is the metadata of a plugin loaded by the provider.
+type pluginV1Metadata struct {
+	pluginID     string
+	kind         string
+	factoryName  string
+	sourceType   string
+	sourceDir    string
+	sourceGitURL string
+	sourceGitRef string
+	sourceIndex  string
+	info         apiv1.PluginInfo
+}
+
+func newDataSourcePlugins() datasource.DataSource {
+	return &dataSourcePlugins{}
+}
+
+type dataSourcePlugins struct {
+	plugins []pluginV1Metadata
+}
+
+func (d *dataSourcePlugins) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `
+Lists the plugins loaded by the provider, with their source code information and the metadata exposed by the plugins.
+`,
+		Attributes: map[string]tfsdk.Attribute{
+			"plugins": {
+				Description: `The plugins loaded by the provider, sorted by kind and plugin ID.`,
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"plugin_id": {
+						Description: `The ID of the plugin.`,
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"kind": {
+						Description: "The kind of the plugin: `resource` or `data_source`.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"factory_name": {
+						Description: `The name of the plugin factory used to make the plugin.`,
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"source": {
+						Description: `The source code information of the plugin.`,
+						Computed:    true,
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"type": {
+								Description: "The type of the source code: `dir` or `git`.",
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"dir": {
+								Description: "The directory of the plugin go module (on git sources, the directory inside the repository).",
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"git_url": {
+								Description: `The URL of the git repository, empty if not a git source.`,
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"git_ref": {
+								Description: `The reference of the git repository, empty if not a git source.`,
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"index": {
+								Description: `The hash of the plugin source code, changes when the source code changes.`,
+								Type:        types.StringType,
+								Computed:    true,
+							},
+						}),
+					},
+					"info": {
+						Description: "The metadata exposed by the plugin (`InfoProvider` optional interface), empty if not exposed.",
+						Computed:    true,
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"name": {
+								Description: `The name of the plugin.`,
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"version": {
+								Description: `The version of the plugin.`,
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"description": {
+								Description: `The description of the plugin.`,
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"docs_url": {
+								Description: `The URL of the plugin documentation.`,
+								Type:        types.StringType,
+								Computed:    true,
+							},
+						}),
+					},
+				}),
+			},
+			"id": {
+				Description: `Not used (Used internally by the provider and Terraform), can be ignored.`,
+				Computed:    true,
+				Type:        types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (d *dataSourcePlugins) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugins"
+}
+
+func (d *dataSourcePlugins) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	dsd, ok := req.ProviderData.(providerInstancedDataSourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerInstancedDataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.plugins = dsd.pluginsMetadata
+}
+
+func (d *dataSourcePlugins) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	plugins := sortPluginsV1Metadata(d.plugins)
+
+	tfPlugins := DataSourcePlugins{
+		ID:      types.StringValue("goplugin_plugins"),
+		Plugins: []DataSourcePluginsPlugin{},
+	}
+	for _, p := range plugins {
+		tfPlugins.Plugins = append(tfPlugins.Plugins, DataSourcePluginsPlugin{
+			PluginID:    types.StringValue(p.pluginID),
+			Kind:        types.StringValue(p.kind),
+			FactoryName: types.StringValue(p.factoryName),
+			Source: DataSourcePluginsPluginSource{
+				Type:   types.StringValue(p.sourceType),
+				Dir:    types.StringValue(p.sourceDir),
+				GitURL: types.StringValue(p.sourceGitURL),
+				GitRef: types.StringValue(p.sourceGitRef),
+				Index:  types.StringValue(p.sourceIndex),
+			},
+			Info: DataSourcePluginsPluginInfo{
+				Name:        types.StringValue(p.info.Name),
+				Version:     types.StringValue(p.info.Version),
+				Description: types.StringValue(p.info.Description),
+				DocsURL:     types.StringValue(p.info.DocsURL),
+			},
+		})
+	}
+
+	diags := resp.State.Set(ctx, tfPlugins)
+	resp.Diagnostics.Append(diags...)
+}
+
+// sortPluginsV1Metadata returns a copy of the plugins metadata sorted by kind and plugin ID.
+func sortPluginsV1Metadata(plugins []pluginV1Metadata) []pluginV1Metadata {
+	sorted := make([]pluginV1Metadata, len(plugins))
+	copy(sorted, plugins)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].kind != sorted[j].kind {
+			return sorted[i].kind < sorted[j].kind
+		}
+		return sorted[i].pluginID < sorted[j].pluginID
+	})
+
+	return sorted
+}
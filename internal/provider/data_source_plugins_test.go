@@ -0,0 +1,93 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/slok/terraform-provider-goplugin/internal/provider"
+)
+
+// TestAccDataSourcePlugins will check the loaded plugins are listed correctly.
+func TestAccDataSourcePlugins(t *testing.T) {
+	config := testAccProviderConfig(`
+  resource_plugins_v1 = {
+    "test_file": {
+      source_code = {
+        dir = "testdata/file_plugin"
+      }
+      configuration = jsonencode({})
+    }
+  }
+
+  data_source_plugins_v1 = {
+    "fake": {
+      source_code = {
+        dir = "testdata/fake_data_source"
+      }
+      configuration = jsonencode({})
+    }
+  }
+`, `
+data "goplugin_plugins" "test" {}
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.#", "2"),
+
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.0.plugin_id", "fake"),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.0.kind", "data_source"),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.0.factory_name", "NewDataSourcePlugin"),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.0.source.type", "dir"),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.0.source.dir", "testdata/fake_data_source"),
+					resource.TestCheckResourceAttrSet("data.goplugin_plugins.test", "plugins.0.source.index"),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.0.info.name", "fake"),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.0.info.version", "v0.1.0"),
+
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.1.plugin_id", "test_file"),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.1.kind", "resource"),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.1.factory_name", "NewResourcePlugin"),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.1.source.type", "dir"),
+					resource.TestCheckResourceAttr("data.goplugin_plugins.test", "plugins.1.info.name", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestSortPluginsV1Metadata(t *testing.T) {
+	tests := map[string]struct {
+		plugins []string
+		exp     []string
+	}{
+		"Without plugins should return an empty list.": {
+			plugins: []string{},
+			exp:     []string{},
+		},
+
+		"Plugins should be sorted by kind and plugin ID.": {
+			plugins: []string{"resource/b", "data_source/z", "resource/a", "data_source/a"},
+			exp:     []string{"data_source/a", "data_source/z", "resource/a", "resource/b"},
+		},
+
+		"Plugins with the same ID and different kind should be sorted by kind.": {
+			plugins: []string{"resource/test", "data_source/test"},
+			exp:     []string{"data_source/test", "resource/test"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(test.exp, provider.SortPluginsV1Metadata(test.plugins))
+		})
+	}
+}
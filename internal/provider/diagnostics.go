@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+// pluginDiagnostics maps the diagnostics returned by the plugins into Terraform diagnostics.
+//
+// All the plugin attributes are on a single Terraform attribute, so diagnostics with an attribute
+// path will point to the `attributes` Terraform attribute and the path will be added to the detail.
+func pluginDiagnostics(pluginDiags []apiv1.Diagnostic) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	for _, d := range pluginDiags {
+		if d.AttributePath == "" {
+			switch d.Severity {
+			case apiv1.DiagnosticSeverityWarning:
+				diags.AddWarning(d.Summary, d.Detail)
+			default:
+				diags.AddError(d.Summary, d.Detail)
+			}
+			continue
+		}
+
+		detail := fmt.Sprintf("Attribute %q: %s", d.AttributePath, d.Detail)
+		switch d.Severity {
+		case apiv1.DiagnosticSeverityWarning:
+			diags.AddAttributeWarning(path.Root("attributes"), d.Summary, detail)
+		default:
+			diags.AddAttributeError(path.Root("attributes"), d.Summary, detail)
+		}
+	}
+
+	return diags
+}
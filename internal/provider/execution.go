@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+// pluginV1ExecutionConfig is the configuration used to execute the operations of a plugin.
+type pluginV1ExecutionConfig struct {
+	timeouts pluginV1Timeouts
+	retry    pluginV1Retry
+}
+
+// pluginV1Timeouts are the default timeouts of the plugin operations, zero means no timeout.
+type pluginV1Timeouts struct {
+	create time.Duration
+	read   time.Duration
+	update time.Duration
+	delete time.Duration
+}
+
+// errPluginOperationTimeout is the error returned when a plugin operation doesn't end in time.
+var errPluginOperationTimeout = errors.New("plugin operation timed out")
+
+// pluginV1Retry is the retry policy used when a plugin operation fails with a retryable error.
+type pluginV1Retry struct {
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	// jitter is the ratio [0, 1] of the backoff that will be randomized.
+	jitter float64
+}
+
+// backoff returns the time to wait before the next attempt, exponential based on the
+// attempts already made and capped by the max backoff (zero means no cap).
+func (r pluginV1Retry) backoff(attempt int) time.Duration {
+	d := r.initialBackoff
+	for i := 1; i < attempt && (r.maxBackoff <= 0 || d < r.maxBackoff) && d <= math.MaxInt64/2; i++ {
+		d *= 2
+	}
+	if r.maxBackoff > 0 && d > r.maxBackoff {
+		d = r.maxBackoff
+	}
+
+	if r.jitter > 0 {
+		delta := r.jitter * float64(d)
+		d = d - time.Duration(delta) + time.Duration(rand.Float64()*2*delta) // nolint: gosec
+	}
+
+	return d
+}
+
+// runPluginOperation executes a plugin operation under a context deadline, retrying the operation
+// while the plugin returns retryable errors and the retry policy allows it. The timeout applies
+// to the whole operation, including all the attempts.
+func runPluginOperation[T any](ctx context.Context, timeout time.Duration, retry pluginV1Retry, f func(ctx context.Context) (T, error)) (T, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	attempt := 0
+	for {
+		attempt++
+		resp, err := runPluginOperationAttempt(ctx, timeout, f)
+		if err == nil || !errors.Is(err, apiv1.ErrRetryable) || attempt >= retry.maxAttempts {
+			if err != nil && attempt > 1 {
+				err = fmt.Errorf("%w (after %d attempts)", err, attempt)
+			}
+			return resp, err
+		}
+
+		backoff := retry.backoff(attempt)
+		tflog.Debug(ctx, "Plugin operation failed with retryable error, retrying", map[string]interface{}{
+			"attempt": attempt,
+			"backoff": backoff.String(),
+			"error":   err.Error(),
+		})
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			var zero T
+			return zero, fmt.Errorf("%w (after %d attempts)", contextError(ctx, timeout), attempt)
+		}
+	}
+}
+
+// runPluginOperationAttempt executes a single attempt of a plugin operation. Plugins may not respect
+// the context, so we don't wait for the plugin once the deadline is exceeded.
+//
+// A timed out attempt is abandoned, not cancelled: the plugin context is cancelled so well-behaved plugins
+// stop, but Go can't stop the plugin goroutine, it will keep running until the plugin returns and its
+// result will be discarded.
+func runPluginOperationAttempt[T any](ctx context.Context, timeout time.Duration, f func(ctx context.Context) (T, error)) (T, error) {
+	if timeout <= 0 {
+		return f(ctx)
+	}
+
+	// Always cancel the plugin context when we stop waiting for the plugin.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		resp T
+		err  error
+	}
+	resC := make(chan result, 1)
+	go func() {
+		resp, err := f(ctx)
+		resC <- result{resp: resp, err: err}
+	}()
+
+	select {
+	case res := <-resC:
+		return res.resp, res.err
+	case <-ctx.Done():
+		var zero T
+		return zero, contextError(ctx, timeout)
+	}
+}
+
+func contextError(ctx context.Context, timeout time.Duration) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w after %s", errPluginOperationTimeout, timeout)
+	}
+	return ctx.Err()
+}
+
+// pluginErrorDiagnostic returns the diagnostic of a failed plugin operation.
+func pluginErrorDiagnostic(err error) diag.Diagnostic {
+	if errors.Is(err, errPluginOperationTimeout) {
+		return diag.NewErrorDiagnostic("Plugin operation timed out", "Plugin execution didn't end in time: "+err.Error())
+	}
+
+	return diag.NewErrorDiagnostic("Error executing plugin", "Plugin execution end in error: "+err.Error())
+}
+
+// operationTimeout returns the timeout configured on the Terraform resource, if not set it will fallback
+// to the plugin default timeout.
+func operationTimeout(timeout types.String, defaultTimeout time.Duration) time.Duration {
+	// Already validated by the schema.
+	d, err := parseDuration(timeout, defaultTimeout)
+	if err != nil {
+		return defaultTimeout
+	}
+
+	return d
+}
+
+// parseDuration parses a duration attribute, if not set it will fallback to the default value.
+func parseDuration(value types.String, defaultValue time.Duration) (time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultValue, nil
+	}
+
+	return time.ParseDuration(value.ValueString())
+}
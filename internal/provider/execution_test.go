@@ -0,0 +1,123 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/slok/terraform-provider-goplugin/internal/provider"
+)
+
+func TestRunPluginOperationTimeout(t *testing.T) {
+	tests := map[string]struct {
+		plugin        func(ctx context.Context, stopped chan<- struct{}) (string, error)
+		expResp       string
+		expErr        bool
+		expCtxStopped bool
+	}{
+		"A plugin ending in time should return its result.": {
+			plugin: func(ctx context.Context, stopped chan<- struct{}) (string, error) {
+				return "ok", nil
+			},
+			expResp: "ok",
+		},
+
+		"A plugin respecting the context should be stopped on timeout.": {
+			plugin: func(ctx context.Context, stopped chan<- struct{}) (string, error) {
+				<-ctx.Done()
+				close(stopped)
+				return "", ctx.Err()
+			},
+			expErr:        true,
+			expCtxStopped: true,
+		},
+
+		"A plugin not respecting the context should be abandoned on timeout.": {
+			plugin: func(ctx context.Context, stopped chan<- struct{}) (string, error) {
+				time.Sleep(time.Second)
+				return "late", nil
+			},
+			expErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			stopped := make(chan struct{})
+			start := time.Now()
+			gotResp, err := provider.RunPluginOperation(context.Background(), 50*time.Millisecond, func(ctx context.Context) (string, error) {
+				return test.plugin(ctx, stopped)
+			})
+
+			if test.expErr {
+				assert.Error(err)
+				assert.Less(time.Since(start), 500*time.Millisecond)
+			} else if assert.NoError(err) {
+				assert.Equal(test.expResp, gotResp)
+			}
+
+			if test.expCtxStopped {
+				select {
+				case <-stopped:
+				case <-time.After(time.Second):
+					assert.Fail("plugin context was not cancelled")
+				}
+			}
+		})
+	}
+}
+
+func TestPluginV1RetryBackoff(t *testing.T) {
+	tests := map[string]struct {
+		initialBackoff time.Duration
+		maxBackoff     time.Duration
+		attempt        int
+		expBackoff     time.Duration
+	}{
+		"The first attempt should use the initial backoff.": {
+			initialBackoff: time.Second,
+			maxBackoff:     30 * time.Second,
+			attempt:        1,
+			expBackoff:     time.Second,
+		},
+
+		"The backoff should be doubled on each attempt.": {
+			initialBackoff: time.Second,
+			maxBackoff:     30 * time.Second,
+			attempt:        4,
+			expBackoff:     8 * time.Second,
+		},
+
+		"The backoff should be capped by the max backoff.": {
+			initialBackoff: time.Second,
+			maxBackoff:     30 * time.Second,
+			attempt:        10,
+			expBackoff:     30 * time.Second,
+		},
+
+		"A zero max backoff should not cap the backoff.": {
+			initialBackoff: time.Second,
+			maxBackoff:     0,
+			attempt:        10,
+			expBackoff:     512 * time.Second,
+		},
+
+		"A zero max backoff should not overflow the backoff.": {
+			initialBackoff: time.Second,
+			maxBackoff:     0,
+			attempt:        100,
+			expBackoff:     (1 << 33) * time.Second,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotBackoff := provider.PluginV1RetryBackoff(test.initialBackoff, test.maxBackoff, test.attempt)
+			assert.Equal(t, test.expBackoff, gotBackoff)
+		})
+	}
+}
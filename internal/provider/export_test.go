@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	pluginv1 "github.com/slok/terraform-provider-goplugin/internal/plugin/v1"
+)
+
+// Internal helpers exported only for the unit tests.
+var (
+	PluginRequiresReplace = pluginRequiresReplace
+)
+
+// RunPluginOperation executes a plugin operation without retries.
+func RunPluginOperation(ctx context.Context, timeout time.Duration, f func(ctx context.Context) (string, error)) (string, error) {
+	return runPluginOperation(ctx, timeout, pluginV1Retry{}, f)
+}
+
+// PluginV1RetryBackoff returns the backoff without jitter of a retry attempt.
+func PluginV1RetryBackoff(initialBackoff, maxBackoff time.Duration, attempt int) time.Duration {
+	return pluginV1Retry{initialBackoff: initialBackoff, maxBackoff: maxBackoff}.backoff(attempt)
+}
+
+// SortPluginsV1Metadata returns the `kind/plugin_id` of the plugins (in `kind/plugin_id` form) in the order
+// listed by the plugins data source.
+func SortPluginsV1Metadata(plugins []string) []string {
+	metadata := []pluginV1Metadata{}
+	for _, p := range plugins {
+		kind, pluginID, _ := strings.Cut(p, "/")
+		metadata = append(metadata, pluginV1Metadata{kind: kind, pluginID: pluginID})
+	}
+
+	sorted := []string{}
+	for _, m := range sortPluginsV1Metadata(metadata) {
+		sorted = append(sorted, m.kind+"/"+m.pluginID)
+	}
+
+	return sorted
+}
+
+// NewAPIV1PluginSandboxPolicy returns the sandbox policy of a plugin sandbox configuration, without sandbox
+// configuration if the imports are nil and unsafe is not set.
+func NewAPIV1PluginSandboxPolicy(allowedImports, deniedImports []string, allowUnsafe *bool) pluginv1.SandboxPolicy {
+	if allowedImports == nil && deniedImports == nil && allowUnsafe == nil {
+		return (&tfProvider{}).newAPIV1PluginSandboxPolicy(nil)
+	}
+
+	sandbox := &providerDataPluginV1Sandbox{
+		AllowedImports: allowedImports,
+		DeniedImports:  deniedImports,
+		AllowUnsafe:    types.BoolNull(),
+	}
+	if allowUnsafe != nil {
+		sandbox.AllowUnsafe = types.BoolValue(*allowUnsafe)
+	}
+
+	return (&tfProvider{}).newAPIV1PluginSandboxPolicy(sandbox)
+}
+
+// NewAPIV1PluginEnv returns the environment of a plugin.
+func NewAPIV1PluginEnv(env map[string]string, inheritEnv []string) ([]string, error) {
+	return (&tfProvider{}).newAPIV1PluginEnv(env, inheritEnv)
+}
+
+// NewAPIV1PluginNetworkPolicy returns the network policy of the plugin allowed hosts.
+func NewAPIV1PluginNetworkPolicy(name string, allowedHosts []string) pluginv1.NetworkPolicy {
+	return (&tfProvider{}).newAPIV1PluginNetworkPolicy(name, allowedHosts)
+}
+
+// NewAPIV1PluginFilesystemPolicy returns the filesystem policy of a plugin filesystem configuration, without
+// filesystem configuration if the root and read-only are not set.
+func NewAPIV1PluginFilesystemPolicy(name string, root *string, readOnly *bool) pluginv1.FilesystemPolicy {
+	if root == nil && readOnly == nil {
+		return (&tfProvider{}).newAPIV1PluginFilesystemPolicy(name, nil)
+	}
+
+	filesystem := &providerDataPluginV1Filesystem{Root: types.StringNull(), ReadOnly: types.BoolNull()}
+	if root != nil {
+		filesystem.Root = types.StringValue(*root)
+	}
+	if readOnly != nil {
+		filesystem.ReadOnly = types.BoolValue(*readOnly)
+	}
+
+	return (&tfProvider{}).newAPIV1PluginFilesystemPolicy(name, filesystem)
+}
+
+// PluginV1Durations are the durations of a plugin configuration.
+type PluginV1Durations struct {
+	ReadTimeout    time.Duration
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	CPUTime        time.Duration
+}
+
+// NewAPIV1PluginDurations returns the durations of a plugin configuration, the empty attributes are not set.
+func NewAPIV1PluginDurations(readTimeout, initialBackoff, maxBackoff, cpuTime string) (PluginV1Durations, error) {
+	value := func(s string) types.String {
+		if s == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(s)
+	}
+
+	p := &tfProvider{}
+	config, err := p.newAPIV1PluginExecutionConfig(
+		&providerDataPluginV1Timeouts{Create: types.StringNull(), Read: value(readTimeout), Update: types.StringNull(), Delete: types.StringNull()},
+		&providerDataPluginV1Retry{MaxAttempts: types.Int64Null(), InitialBackoff: value(initialBackoff), MaxBackoff: value(maxBackoff), Jitter: types.Float64Null()},
+	)
+	if err != nil {
+		return PluginV1Durations{}, err
+	}
+
+	limits, err := p.newAPIV1PluginProcessLimits(types.StringValue(string(pluginv1.ExecutionModeProcess)), &providerDataPluginV1ProcessLimits{CPUTime: value(cpuTime), MemoryMB: types.Int64Null()})
+	if err != nil {
+		return PluginV1Durations{}, err
+	}
+
+	return PluginV1Durations{
+		ReadTimeout:    config.timeouts.read,
+		InitialBackoff: config.retry.initialBackoff,
+		MaxBackoff:     config.retry.maxBackoff,
+		CPUTime:        limits.CPUTime,
+	}, nil
+}
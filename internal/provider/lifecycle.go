@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	pluginv1 "github.com/slok/terraform-provider-goplugin/internal/plugin/v1"
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+// healthCheckPlugin will check the plugin is ready to be used using the plugin, health checking is optional
+// for the plugins. The health check uses the read timeout and the retry policy of the plugin.
+func healthCheckPlugin(ctx context.Context, providerVersion, pluginID string, plugin interface{}, executionConfig pluginV1ExecutionConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !pluginv1.Supports(plugin, pluginv1.CapabilityHealthChecker) {
+		return diags
+	}
+	checker := plugin.(apiv1.HealthChecker)
+
+	ctx = pluginOperationContext(ctx, apiv1.RequestInfo{
+		PluginID:        pluginID,
+		Operation:       apiv1.OperationHealthCheck,
+		Workspace:       os.Getenv("TF_WORKSPACE"),
+		ProviderVersion: providerVersion,
+	})
+	pluginResp, err := runPluginOperation(ctx, executionConfig.timeouts.read, executionConfig.retry, func(ctx context.Context) (*apiv1.HealthCheckResponse, error) {
+		return checker.HealthCheck(ctx, apiv1.HealthCheckRequest{})
+	})
+	if err != nil {
+		diags.AddError("Plugin health check failed", fmt.Sprintf("Plugin %q is not healthy: %s", pluginID, err))
+		return diags
+	}
+
+	diags.Append(pluginDiagnostics(pluginResp.Diagnostics)...)
+
+	return diags
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+// defaultPluginsLoadConcurrency is the max number of plugins loaded at the same time by default.
+const defaultPluginsLoadConcurrency = 4
+
+// loadedPluginV1 is a plugin loaded by the provider that is ready to be registered.
+type loadedPluginV1 struct {
+	pluginID        string
+	plugin          interface{}
+	executionConfig pluginV1ExecutionConfig
+	metadata        pluginV1Metadata
+}
+
+// pluginV1Loader loads one or more plugins (e.g: a plugin module), the errors are returned as diagnostics.
+type pluginV1Loader func(ctx context.Context) ([]loadedPluginV1, diag.Diagnostics)
+
+// loadPluginsConcurrently runs the plugin loaders concurrently with a max concurrency. All the loaders are executed
+// even if some of them fail, so all the loading errors are returned at once. The loaded plugins and the
+// diagnostics are returned in the same order as the loaders.
+func loadPluginsConcurrently(ctx context.Context, concurrency int, loaders []pluginV1Loader) ([]loadedPluginV1, diag.Diagnostics) {
+	type result struct {
+		plugins []loadedPluginV1
+		diags   diag.Diagnostics
+	}
+
+	results := make([]result, len(loaders))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, loader := range loaders {
+		i, loader := i, loader
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			plugins, diags := loader(ctx)
+			results[i] = result{plugins: plugins, diags: diags}
+		}()
+	}
+	wg.Wait()
+
+	plugins := []loadedPluginV1{}
+	var diags diag.Diagnostics
+	for _, r := range results {
+		plugins = append(plugins, r.plugins...)
+		diags.Append(r.diags...)
+	}
+
+	return plugins, diags
+}
+
+// registeredPluginsV1 are the plugins registered on the provider.
+type registeredPluginsV1 struct {
+	resourcePlugins            map[string]apiv1.ResourcePlugin
+	resourceExecutionConfigs   map[string]pluginV1ExecutionConfig
+	dataSourcePlugins          map[string]apiv1.DataSourcePlugin
+	dataSourceExecutionConfigs map[string]pluginV1ExecutionConfig
+	metadata                   []pluginV1Metadata
+}
+
+// registerPlugins registers the loaded plugins by their kind, plugin IDs must be unique per kind.
+func registerPlugins(plugins []loadedPluginV1) (registeredPluginsV1, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	registered := registeredPluginsV1{
+		resourcePlugins:            map[string]apiv1.ResourcePlugin{},
+		resourceExecutionConfigs:   map[string]pluginV1ExecutionConfig{},
+		dataSourcePlugins:          map[string]apiv1.DataSourcePlugin{},
+		dataSourceExecutionConfigs: map[string]pluginV1ExecutionConfig{},
+		metadata:                   []pluginV1Metadata{},
+	}
+
+	for _, p := range plugins {
+		// Plugins can implement both kinds, so we use the kind they were loaded as.
+		switch p.metadata.kind {
+		case pluginKindResource:
+			if _, ok := registered.resourcePlugins[p.pluginID]; ok {
+				diags.AddError("Duplicated resource plugin", fmt.Sprintf("Resource plugin %q is already registered", p.pluginID))
+				continue
+			}
+			registered.resourcePlugins[p.pluginID] = p.plugin.(apiv1.ResourcePlugin)
+			registered.resourceExecutionConfigs[p.pluginID] = p.executionConfig
+
+		case pluginKindDataSource:
+			if _, ok := registered.dataSourcePlugins[p.pluginID]; ok {
+				diags.AddError("Duplicated data source plugin", fmt.Sprintf("Data source plugin %q is already registered", p.pluginID))
+				continue
+			}
+			registered.dataSourcePlugins[p.pluginID] = p.plugin.(apiv1.DataSourcePlugin)
+			registered.dataSourceExecutionConfigs[p.pluginID] = p.executionConfig
+		}
+
+		registered.metadata = append(registered.metadata, p.metadata)
+	}
+
+	return registered, diags
+}
+
+// sortedKeys returns the keys of a map sorted, used to load the plugins in a deterministic order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
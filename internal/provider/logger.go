@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+// pluginOperationContext returns the context for a plugin operation with the request information, and
+// a plugin logger that logs using a Terraform log subsystem named after the plugin ID.
+func pluginOperationContext(ctx context.Context, info apiv1.RequestInfo) context.Context {
+	ctx = tflog.NewSubsystem(ctx, info.PluginID)
+	ctx = tflog.SubsystemSetField(ctx, info.PluginID, "operation", info.Operation)
+	if info.ResourceID != "" {
+		ctx = tflog.SubsystemSetField(ctx, info.PluginID, "resource_id", info.ResourceID)
+	}
+
+	ctx = apiv1.ContextWithRequestInfo(ctx, info)
+	return apiv1.ContextWithLogger(ctx, tflogLogger{ctx: ctx, subsystem: info.PluginID})
+}
+
+// tflogLogger is the `apiv1.Logger` implementation for plugins that uses a tflog subsystem.
+type tflogLogger struct {
+	ctx       context.Context
+	subsystem string
+}
+
+func (t tflogLogger) Debugf(format string, args ...interface{}) {
+	tflog.SubsystemDebug(t.ctx, t.subsystem, fmt.Sprintf(format, args...))
+}
+
+func (t tflogLogger) Infof(format string, args ...interface{}) {
+	tflog.SubsystemInfo(t.ctx, t.subsystem, fmt.Sprintf(format, args...))
+}
+
+func (t tflogLogger) Warningf(format string, args ...interface{}) {
+	tflog.SubsystemWarn(t.ctx, t.subsystem, fmt.Sprintf(format, args...))
+}
+
+func (t tflogLogger) Errorf(format string, args ...interface{}) {
+	tflog.SubsystemError(t.ctx, t.subsystem, fmt.Sprintf(format, args...))
+}
+
+func (t tflogLogger) WithValues(values map[string]interface{}) apiv1.Logger {
+	ctx := t.ctx
+	for k, v := range values {
+		ctx = tflog.SubsystemSetField(ctx, t.subsystem, k, v)
+	}
+
+	return tflogLogger{ctx: ctx, subsystem: t.subsystem}
+}
+
+// pluginOutputWriter is an `io.Writer` that logs each line written by the plugin (e.g: `fmt.Println`)
+// using a Terraform log subsystem named after the plugin ID.
+type pluginOutputWriter struct {
+	ctx       context.Context
+	subsystem string
+	log       func(ctx context.Context, subsystem, msg string, additionalFields ...map[string]interface{})
+
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+// maxPluginOutputLineSize is the max size of a line before being logged without waiting for the line end.
+const maxPluginOutputLineSize = 64 * 1024
+
+func newPluginStdoutWriter(ctx context.Context, pluginID string) *pluginOutputWriter {
+	ctx = tflog.NewSubsystem(ctx, pluginID)
+	ctx = tflog.SubsystemSetField(ctx, pluginID, "output", "stdout")
+	return &pluginOutputWriter{ctx: ctx, subsystem: pluginID, log: tflog.SubsystemInfo}
+}
+
+func newPluginStderrWriter(ctx context.Context, pluginID string) *pluginOutputWriter {
+	ctx = tflog.NewSubsystem(ctx, pluginID)
+	ctx = tflog.SubsystemSetField(ctx, pluginID, "output", "stderr")
+	return &pluginOutputWriter{ctx: ctx, subsystem: pluginID, log: tflog.SubsystemWarn}
+}
+
+func (p *pluginOutputWriter) Write(data []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.buf.Write(data)
+	for {
+		i := bytes.IndexByte(p.buf.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+		line := p.buf.Next(i + 1)
+		p.log(p.ctx, p.subsystem, string(bytes.TrimRight(line, "\r\n")))
+	}
+
+	if p.buf.Len() >= maxPluginOutputLineSize {
+		p.log(p.ctx, p.subsystem, p.buf.String())
+		p.buf.Reset()
+	}
+
+	return len(data), nil
+}
@@ -5,15 +5,102 @@ import (
 )
 
 type ResourcePluginV1 struct {
+	ID                types.String              `tfsdk:"id"`
+	ResourceID        types.String              `tfsdk:"resource_id"`
+	PluginID          types.String              `tfsdk:"plugin_id"`
+	Attributes        types.String              `tfsdk:"attributes"`
+	AttributesVersion types.Int64               `tfsdk:"attributes_version"`
+	Outputs           types.String              `tfsdk:"outputs"`
+	Timeouts          *ResourcePluginV1Timeouts `tfsdk:"timeouts"`
+}
+
+// ResourcePluginV1V0 is the version 0 of the ResourcePluginV1 schema.
+type ResourcePluginV1V0 struct {
 	ID         types.String `tfsdk:"id"`
 	ResourceID types.String `tfsdk:"resource_id"`
 	PluginID   types.String `tfsdk:"plugin_id"`
 	Attributes types.String `tfsdk:"attributes"`
+	Outputs    types.String `tfsdk:"outputs"`
 }
 
 type DataSourcePluginV1 struct {
-	ID         types.String `tfsdk:"id"`
-	PluginID   types.String `tfsdk:"plugin_id"`
-	Attributes types.String `tfsdk:"attributes"`
-	Result     types.String `tfsdk:"result"`
+	ID         types.String                `tfsdk:"id"`
+	PluginID   types.String                `tfsdk:"plugin_id"`
+	Attributes types.String                `tfsdk:"attributes"`
+	Result     types.String                `tfsdk:"result"`
+	Timeouts   *DataSourcePluginV1Timeouts `tfsdk:"timeouts"`
+}
+
+type DataSourcePlugins struct {
+	ID      types.String              `tfsdk:"id"`
+	Plugins []DataSourcePluginsPlugin `tfsdk:"plugins"`
+}
+
+type DataSourcePluginsPlugin struct {
+	PluginID    types.String                  `tfsdk:"plugin_id"`
+	Kind        types.String                  `tfsdk:"kind"`
+	FactoryName types.String                  `tfsdk:"factory_name"`
+	Source      DataSourcePluginsPluginSource `tfsdk:"source"`
+	Info        DataSourcePluginsPluginInfo   `tfsdk:"info"`
+}
+
+type DataSourcePluginsPluginSource struct {
+	Type   types.String `tfsdk:"type"`
+	Dir    types.String `tfsdk:"dir"`
+	GitURL types.String `tfsdk:"git_url"`
+	GitRef types.String `tfsdk:"git_ref"`
+	Index  types.String `tfsdk:"index"`
+}
+
+type DataSourcePluginsPluginInfo struct {
+	Name        types.String `tfsdk:"name"`
+	Version     types.String `tfsdk:"version"`
+	Description types.String `tfsdk:"description"`
+	DocsURL     types.String `tfsdk:"docs_url"`
+}
+
+type ResourcePluginV1Timeouts struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+func (t *ResourcePluginV1Timeouts) createTimeout() types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return t.Create
+}
+
+func (t *ResourcePluginV1Timeouts) readTimeout() types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return t.Read
+}
+
+func (t *ResourcePluginV1Timeouts) updateTimeout() types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return t.Update
+}
+
+func (t *ResourcePluginV1Timeouts) deleteTimeout() types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return t.Delete
+}
+
+type DataSourcePluginV1Timeouts struct {
+	Read types.String `tfsdk:"read"`
+}
+
+func (t *DataSourcePluginV1Timeouts) readTimeout() types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return t.Read
 }
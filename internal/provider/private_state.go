@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// pluginPrivateStateKey is the key used to store the plugin private data on the resource private state.
+const pluginPrivateStateKey = "plugin_private"
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getPluginPrivate returns the plugin private data stored on the resource private state.
+func getPluginPrivate(ctx context.Context, p privateStateGetter) (string, diag.Diagnostics) {
+	data, diags := p.GetKey(ctx, pluginPrivateStateKey)
+	if diags.HasError() || data == nil {
+		return "", diags
+	}
+
+	// Private state values must be JSON, so we store the plugin data as a JSON string.
+	var private string
+	err := json.Unmarshal(data, &private)
+	if err != nil {
+		diags.AddError("Invalid private state", fmt.Sprintf("Could not decode plugin private data: %s", err))
+		return "", diags
+	}
+
+	return private, diags
+}
+
+// setPluginPrivate stores the plugin private data on the resource private state, empty data will be ignored.
+func setPluginPrivate(ctx context.Context, p privateStateSetter, private string) diag.Diagnostics {
+	if private == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(private)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Invalid private state", fmt.Sprintf("Could not encode plugin private data: %s", err))}
+	}
+
+	return p.SetKey(ctx, pluginPrivateStateKey, data)
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -20,11 +23,46 @@ import (
 	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
 )
 
-func New() provider.Provider {
-	return &tfProvider{}
+const (
+	providerTypeName = "goplugin"
+	pluginV1TypeName = providerTypeName + "_plugin_v1"
+)
+
+// pluginsCloseTimeout is the max time the plugins have to close when the provider stops.
+const pluginsCloseTimeout = 10 * time.Second
+
+// New returns a new provider factory. The plugins loaded by the provider will be closed once the context
+// is done, use the returned function to wait until all the plugins are closed.
+func New(ctx context.Context, version string) (factory func() provider.Provider, waitClose func() error) {
+	engine := pluginv1.NewEngine()
+
+	var closeErr error
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		<-ctx.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), pluginsCloseTimeout)
+		defer cancel()
+		closeErr = engine.Close(ctx)
+	}()
+
+	factory = func() provider.Provider {
+		return &tfProvider{version: version, pluginV1Engine: engine}
+	}
+
+	waitClose = func() error {
+		<-closed
+		return closeErr
+	}
+
+	return factory, waitClose
 }
 
-type tfProvider struct{}
+type tfProvider struct {
+	version        string
+	pluginV1Engine *pluginv1.Engine
+}
 
 var (
 	pluginSourceCodeAttribute = tfsdk.Attribute{
@@ -81,6 +119,159 @@ var (
 		}),
 	}
 
+	pluginTimeoutsAttribute = tfsdk.Attribute{
+		Optional: true,
+		Description: `Default timeouts of the plugin operations (e.g: ` + "`30s`, `5m`, `1h`" + `), can be overridden with the ` + "`timeouts`" + ` of each resource or data source.
+		If not set, the operations will not have timeout. Data source plugins only use ` + "`read`" + `.
+		The rest of the operations (health check, validation, plan, import and attributes comparison and upgrade) use the ` + "`read`" + ` timeout.`,
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"create": {
+				Optional:    true,
+				Description: `Timeout of the create operation.`,
+				Validators:  []tfsdk.AttributeValidator{attributeutils.MustDuration},
+				Type:        types.StringType,
+			},
+			"read": {
+				Optional:    true,
+				Description: `Timeout of the read operation, also used by the rest of the operations that are not create, update or delete.`,
+				Validators:  []tfsdk.AttributeValidator{attributeutils.MustDuration},
+				Type:        types.StringType,
+			},
+			"update": {
+				Optional:    true,
+				Description: `Timeout of the update operation.`,
+				Validators:  []tfsdk.AttributeValidator{attributeutils.MustDuration},
+				Type:        types.StringType,
+			},
+			"delete": {
+				Optional:    true,
+				Description: `Timeout of the delete operation.`,
+				Validators:  []tfsdk.AttributeValidator{attributeutils.MustDuration},
+				Type:        types.StringType,
+			},
+		}),
+	}
+
+	pluginRetryAttribute = tfsdk.Attribute{
+		Optional: true,
+		Description: `Retry policy of all the plugin operations, only the operations that fail with a retryable error (` + "`apiv1.ErrRetryable`" + `) will be retried.
+		If not set, the operations will not be retried.`,
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"max_attempts": {
+				Optional:    true,
+				Description: "Maximum number of attempts (including the first one) of an operation, `3` by default.",
+				Type:        types.Int64Type,
+			},
+			"initial_backoff": {
+				Optional:    true,
+				Description: "Time to wait before the first retry, it will be doubled on each retry, `1s` by default.",
+				Validators:  []tfsdk.AttributeValidator{attributeutils.MustDuration},
+				Type:        types.StringType,
+			},
+			"max_backoff": {
+				Optional:    true,
+				Description: "Maximum time to wait between retries, `30s` by default, `0s` means no maximum.",
+				Validators:  []tfsdk.AttributeValidator{attributeutils.MustDuration},
+				Type:        types.StringType,
+			},
+			"jitter": {
+				Optional:    true,
+				Description: "Ratio (from `0` to `1`) of the backoff that will be randomized, `0` (no jitter) by default.",
+				Type:        types.Float64Type,
+			},
+		}),
+	}
+
+	pluginSandboxAttribute = tfsdk.Attribute{
+		Optional: true,
+		Description: `Sandbox policy of the standard library packages that the plugin can import, the imports can be package paths (e.g: ` + "`net/http`" + `) or package trees (e.g: ` + "`net/...`" + `).
+		The plugin will fail to load if its source code (including vendored dependencies) imports a forbidden package. If not set, all the standard library can be imported except ` + "`unsafe`" + `.`,
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"allowed_imports": {
+				Optional:    true,
+				Description: "The only standard library packages that the plugin can import, all by default.",
+				Type:        types.ListType{ElemType: types.StringType},
+			},
+			"denied_imports": {
+				Optional:    true,
+				Description: "The standard library packages that the plugin can't import, these have priority over `allowed_imports`.",
+				Type:        types.ListType{ElemType: types.StringType},
+			},
+			"allow_unsafe": {
+				Optional:    true,
+				Description: "Allows the plugin to import `unsafe` package, `false` by default.",
+				Type:        types.BoolType,
+			},
+		}),
+	}
+
+	pluginEnvAttribute = tfsdk.Attribute{
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Environment variables that will be set on the plugin environment, these have priority over the inherited ones.",
+		Type:        types.MapType{ElemType: types.StringType},
+	}
+
+	pluginInheritEnvAttribute = tfsdk.Attribute{
+		Optional: true,
+		Description: "Names or glob patterns (e.g: `AWS_*`) of the provider environment variables that the plugin will inherit. " +
+			"If not set, the plugin doesn't inherit any, use `[\"*\"]` to inherit all the provider environment.",
+		Type: types.ListType{ElemType: types.StringType},
+	}
+
+	pluginAllowedHostsAttribute = tfsdk.Attribute{
+		Optional: true,
+		Description: "Hosts (e.g: `api.github.com`, `*.github.com`), IPs and CIDRs (e.g: `10.0.0.0/8`), with an optional port (e.g: `api.github.com:443`), " +
+			"that the plugin can connect to using `net` and `net/http` packages. If not set, the plugin can connect to any destination, use an empty list to deny all.",
+		Type: types.ListType{ElemType: types.StringType},
+	}
+
+	pluginFilesystemAttribute = tfsdk.Attribute{
+		Optional: true,
+		Description: `Filesystem policy of the plugin host filesystem access using ` + "`os`" + `, ` + "`io/ioutil`" + `, ` + "`io/fs`" + ` and ` + "`path/filepath`" + ` packages.
+		If not set, the plugin can read and write all the host filesystem, if set, the plugin can't start processes nor use the packages that open host paths on their own (e.g: ` + "`text/template`" + `).`,
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"root": {
+				Optional: true,
+				Description: "Host directory the plugin paths are relative to (e.g: `/data` will be `<root>/data`). " +
+					"Paths and symlinks that escape the root are denied, and the plugin can't create links nor change the working directory.",
+				Type: types.StringType,
+			},
+			"read_only": {
+				Optional:    true,
+				Description: "Denies the plugin filesystem write operations, `false` by default.",
+				Type:        types.BoolType,
+			},
+		}),
+	}
+
+	pluginExecutionAttribute = tfsdk.Attribute{
+		Optional: true,
+		Description: "Where the plugin is executed, `in_process` (the provider process) or `process` (a child process of the provider), `in_process` by default. " +
+			"The `process` execution isolates the provider from the plugin crashes (e.g: panics in goroutines), hung plugins and resource usage, " +
+			"the plugin process is restarted on the next operation after a crash.",
+		Type: types.StringType,
+	}
+
+	pluginProcessLimitsAttribute = tfsdk.Attribute{
+		Optional: true,
+		Description: `Resource limits of the plugin process, only with ` + "`process`" + ` execution. The plugin process is killed when a limit is exceeded.
+		If not set, the plugin process resources are not limited.`,
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"cpu_time": {
+				Optional:    true,
+				Description: "CPU time (e.g: `30s`, `5m`) that the plugin process can use during its lifetime, only supported on Linux, macOS and FreeBSD.",
+				Validators:  []tfsdk.AttributeValidator{attributeutils.MustDuration},
+				Type:        types.StringType,
+			},
+			"memory_mb": {
+				Optional:    true,
+				Description: "Memory in megabytes that the plugin process can use.",
+				Type:        types.Int64Type,
+			},
+		}),
+	}
+
 	pluginConfigurationAttribute = tfsdk.Attribute{
 		Required:    true,
 		Sensitive:   true,
@@ -91,7 +282,8 @@ var (
 )
 
 func (p *tfProvider) Metadata(_ context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
-	resp.TypeName = "goplugin"
+	resp.TypeName = providerTypeName
+	resp.Version = p.version
 }
 
 // GetSchema returns the schema that the user must configure on the provider block.
@@ -107,12 +299,26 @@ Terraform go plugin provider is a Terraform provider that will let you execute G
 - Check [Go v1 lib](https://pkg.go.dev/github.com/slok/terraform-provider-goplugin/pkg/api/v1).
 `,
 		Attributes: map[string]tfsdk.Attribute{
+			"plugins_load_concurrency": {
+				Optional:    true,
+				Description: "The maximum number of plugins (and plugin modules) loaded concurrently when the provider is configured, `4` by default.",
+				Type:        types.Int64Type,
+			},
 			"resource_plugins_v1": {
 				Optional:    true,
 				Description: `The Block of resource plugins using v1 API that will be loaded by the provider.`,
 				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
-					"source_code":   pluginSourceCodeAttribute,
-					"configuration": pluginConfigurationAttribute,
+					"source_code":    pluginSourceCodeAttribute,
+					"configuration":  pluginConfigurationAttribute,
+					"timeouts":       pluginTimeoutsAttribute,
+					"retry":          pluginRetryAttribute,
+					"sandbox":        pluginSandboxAttribute,
+					"env":            pluginEnvAttribute,
+					"inherit_env":    pluginInheritEnvAttribute,
+					"allowed_hosts":  pluginAllowedHostsAttribute,
+					"filesystem":     pluginFilesystemAttribute,
+					"execution":      pluginExecutionAttribute,
+					"process_limits": pluginProcessLimitsAttribute,
 					"factory_name": {
 						Optional: true,
 						Description: "The name of the plugin factory (in the source code) that will be used to make instances of the plugin, `NewResourcePlugin` by default, " +
@@ -127,8 +333,17 @@ Terraform go plugin provider is a Terraform provider that will let you execute G
 				Optional:    true,
 				Description: `The Block of data source plugins using v1 API that will be loaded by the provider.`,
 				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
-					"source_code":   pluginSourceCodeAttribute,
-					"configuration": pluginConfigurationAttribute,
+					"source_code":    pluginSourceCodeAttribute,
+					"configuration":  pluginConfigurationAttribute,
+					"timeouts":       pluginTimeoutsAttribute,
+					"retry":          pluginRetryAttribute,
+					"sandbox":        pluginSandboxAttribute,
+					"env":            pluginEnvAttribute,
+					"inherit_env":    pluginInheritEnvAttribute,
+					"allowed_hosts":  pluginAllowedHostsAttribute,
+					"filesystem":     pluginFilesystemAttribute,
+					"execution":      pluginExecutionAttribute,
+					"process_limits": pluginProcessLimitsAttribute,
 					"factory_name": {
 						Optional: true,
 						Description: "The name of the plugin factory (in the source code) that will be used to make instances of the plugin, `NewDataSourcePlugin` by default, " +
@@ -139,20 +354,101 @@ Terraform go plugin provider is a Terraform provider that will let you execute G
 					},
 				}),
 			},
+			"plugin_modules_v1": {
+				Optional: true,
+				Description: `The Block of plugin modules using v1 API that will be loaded by the provider. A plugin module registers multiple resource and data source plugins
+				using a plugins registry function, all of them will be available with ` + "`<module_id>.<name>`" + ` plugin IDs.`,
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"source_code":    pluginSourceCodeAttribute,
+					"configuration":  pluginConfigurationAttribute,
+					"timeouts":       pluginTimeoutsAttribute,
+					"retry":          pluginRetryAttribute,
+					"sandbox":        pluginSandboxAttribute,
+					"env":            pluginEnvAttribute,
+					"inherit_env":    pluginInheritEnvAttribute,
+					"allowed_hosts":  pluginAllowedHostsAttribute,
+					"filesystem":     pluginFilesystemAttribute,
+					"execution":      pluginExecutionAttribute,
+					"process_limits": pluginProcessLimitsAttribute,
+					"registry_name": {
+						Optional:    true,
+						Description: "The name of the plugins registry function (in the source code) that returns the plugin factories of the module, `Plugins` by default.",
+						Type:        types.StringType,
+						// TODO(slok): Provider config doesn't support plan modifiers, set default on `Configure` until are supported.
+						// PlanModifiers: tfsdk.AttributePlanModifiers{attributeutils.DefaultValue(types.StringValue("Plugins"))},
+					},
+				}),
+			},
 		},
 	}, nil
 }
 
 // Provider configuration.
 type providerData struct {
-	ResourcePluginsV1   map[string]providerDataPluginV1 `tfsdk:"resource_plugins_v1"`
-	DataSourcePluginsV1 map[string]providerDataPluginV1 `tfsdk:"data_source_plugins_v1"`
+	ResourcePluginsV1      map[string]providerDataPluginV1       `tfsdk:"resource_plugins_v1"`
+	DataSourcePluginsV1    map[string]providerDataPluginV1       `tfsdk:"data_source_plugins_v1"`
+	PluginModulesV1        map[string]providerDataPluginModuleV1 `tfsdk:"plugin_modules_v1"`
+	PluginsLoadConcurrency types.Int64                           `tfsdk:"plugins_load_concurrency"`
+}
+
+type providerDataPluginModuleV1 struct {
+	SourceCode    providerDataPluginV1Source         `tfsdk:"source_code"`
+	Configuration types.String                       `tfsdk:"configuration"`
+	RegistryName  types.String                       `tfsdk:"registry_name"`
+	Timeouts      *providerDataPluginV1Timeouts      `tfsdk:"timeouts"`
+	Retry         *providerDataPluginV1Retry         `tfsdk:"retry"`
+	Sandbox       *providerDataPluginV1Sandbox       `tfsdk:"sandbox"`
+	Env           map[string]string                  `tfsdk:"env"`
+	InheritEnv    []string                           `tfsdk:"inherit_env"`
+	AllowedHosts  []string                           `tfsdk:"allowed_hosts"`
+	Filesystem    *providerDataPluginV1Filesystem    `tfsdk:"filesystem"`
+	Execution     types.String                       `tfsdk:"execution"`
+	ProcessLimits *providerDataPluginV1ProcessLimits `tfsdk:"process_limits"`
 }
 
 type providerDataPluginV1 struct {
-	SourceCode    providerDataPluginV1Source `tfsdk:"source_code"`
-	Configuration types.String               `tfsdk:"configuration"`
-	FactoryName   types.String               `tfsdk:"factory_name"`
+	SourceCode    providerDataPluginV1Source         `tfsdk:"source_code"`
+	Configuration types.String                       `tfsdk:"configuration"`
+	FactoryName   types.String                       `tfsdk:"factory_name"`
+	Timeouts      *providerDataPluginV1Timeouts      `tfsdk:"timeouts"`
+	Retry         *providerDataPluginV1Retry         `tfsdk:"retry"`
+	Sandbox       *providerDataPluginV1Sandbox       `tfsdk:"sandbox"`
+	Env           map[string]string                  `tfsdk:"env"`
+	InheritEnv    []string                           `tfsdk:"inherit_env"`
+	AllowedHosts  []string                           `tfsdk:"allowed_hosts"`
+	Filesystem    *providerDataPluginV1Filesystem    `tfsdk:"filesystem"`
+	Execution     types.String                       `tfsdk:"execution"`
+	ProcessLimits *providerDataPluginV1ProcessLimits `tfsdk:"process_limits"`
+}
+
+type providerDataPluginV1Retry struct {
+	MaxAttempts    types.Int64   `tfsdk:"max_attempts"`
+	InitialBackoff types.String  `tfsdk:"initial_backoff"`
+	MaxBackoff     types.String  `tfsdk:"max_backoff"`
+	Jitter         types.Float64 `tfsdk:"jitter"`
+}
+
+type providerDataPluginV1Sandbox struct {
+	AllowedImports []string   `tfsdk:"allowed_imports"`
+	DeniedImports  []string   `tfsdk:"denied_imports"`
+	AllowUnsafe    types.Bool `tfsdk:"allow_unsafe"`
+}
+
+type providerDataPluginV1Filesystem struct {
+	Root     types.String `tfsdk:"root"`
+	ReadOnly types.Bool   `tfsdk:"read_only"`
+}
+
+type providerDataPluginV1ProcessLimits struct {
+	CPUTime  types.String `tfsdk:"cpu_time"`
+	MemoryMB types.Int64  `tfsdk:"memory_mb"`
+}
+
+type providerDataPluginV1Timeouts struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
 }
 
 type providerDataPluginV1Source struct {
@@ -180,40 +476,56 @@ func (p *tfProvider) Configure(ctx context.Context, req provider.ConfigureReques
 		return
 	}
 
-	pluginV1Engine := pluginv1.NewEngine()
+	// TODO(slok): Provider config doesn't support plan modifiers, set default here until are supported.
+	concurrency := defaultPluginsLoadConcurrency
+	if !config.PluginsLoadConcurrency.IsNull() {
+		concurrency = int(config.PluginsLoadConcurrency.ValueInt64())
+	}
+	if concurrency < 1 {
+		resp.Diagnostics.AddError("Invalid provider configuration", "plugins_load_concurrency must be at least 1")
+		return
+	}
 
-	// Load resource plugins.
-	resourcePlugins := map[string]apiv1.ResourcePlugin{}
-	for pluginID, pluginConfig := range config.ResourcePluginsV1 {
-		plugin, err := p.loadAPIV1ResourcePlugin(ctx, pluginV1Engine, pluginConfig)
-		if err != nil {
-			resp.Diagnostics.AddError("Error while loading resource plugin", fmt.Sprintf("Could not load plugin resource %q due to an error: %s", pluginID, err.Error()))
-			return
-		}
-		resourcePlugins[pluginID] = plugin
+	// Load all the plugins concurrently.
+	pluginV1Engine := p.pluginV1Engine
+	loaders := []pluginV1Loader{}
+	for _, pluginID := range sortedKeys(config.ResourcePluginsV1) {
+		loaders = append(loaders, p.newAPIV1ResourcePluginLoader(pluginV1Engine, pluginID, config.ResourcePluginsV1[pluginID]))
+	}
+	for _, pluginID := range sortedKeys(config.DataSourcePluginsV1) {
+		loaders = append(loaders, p.newAPIV1DataSourcePluginLoader(pluginV1Engine, pluginID, config.DataSourcePluginsV1[pluginID]))
+	}
+	for _, moduleID := range sortedKeys(config.PluginModulesV1) {
+		loaders = append(loaders, p.newAPIV1PluginModuleLoader(pluginV1Engine, moduleID, config.PluginModulesV1[moduleID]))
 	}
 
-	// Load data source plugins.
-	dataSourcePlugins := map[string]apiv1.DataSourcePlugin{}
-	for pluginID, pluginConfig := range config.DataSourcePluginsV1 {
-		plugin, err := p.loadAPIV1DataSourcePlugin(ctx, pluginV1Engine, pluginConfig)
-		if err != nil {
-			resp.Diagnostics.AddError("Error while loading data source plugin", fmt.Sprintf("Could not load data source plugin %q due to an error: %s", pluginID, err.Error()))
-			return
-		}
-		dataSourcePlugins[pluginID] = plugin
+	loadedPlugins, diags := loadPluginsConcurrently(ctx, concurrency, loaders)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	resp.DataSourceData = providerInstancedDataSourceData{plugins: dataSourcePlugins}
-	resp.ResourceData = providerInstancedResourceData{plugins: resourcePlugins}
+	plugins, diags := registerPlugins(loadedPlugins)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.DataSourceData = providerInstancedDataSourceData{plugins: plugins.dataSourcePlugins, executionConfigs: plugins.dataSourceExecutionConfigs, providerVersion: p.version, pluginsMetadata: plugins.metadata}
+	resp.ResourceData = providerInstancedResourceData{plugins: plugins.resourcePlugins, executionConfigs: plugins.resourceExecutionConfigs, providerVersion: p.version}
 }
 
 type providerInstancedResourceData struct {
-	plugins map[string]apiv1.ResourcePlugin
+	plugins          map[string]apiv1.ResourcePlugin
+	executionConfigs map[string]pluginV1ExecutionConfig
+	providerVersion  string
 }
 
 type providerInstancedDataSourceData struct {
-	plugins map[string]apiv1.DataSourcePlugin
+	plugins          map[string]apiv1.DataSourcePlugin
+	executionConfigs map[string]pluginV1ExecutionConfig
+	providerVersion  string
+	pluginsMetadata  []pluginV1Metadata
 }
 
 func (p *tfProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -225,13 +537,90 @@ func (p *tfProvider) Resources(_ context.Context) []func() resource.Resource {
 func (p *tfProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newDataSourcePluginV1,
+		newDataSourcePlugins,
+	}
+}
+
+func (p *tfProvider) newAPIV1ResourcePluginLoader(pluginFactory *pluginv1.Engine, pluginID string, pluginConfig providerDataPluginV1) pluginV1Loader {
+	return func(ctx context.Context) ([]loadedPluginV1, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		plugin, metadata, err := p.loadAPIV1ResourcePlugin(ctx, pluginFactory, pluginID, pluginConfig)
+		if err != nil {
+			diags.AddError("Error while loading resource plugin", fmt.Sprintf("Could not load plugin resource %q due to an error: %s", pluginID, err.Error()))
+			return nil, diags
+		}
+		executionConfig, err := p.newAPIV1PluginExecutionConfig(pluginConfig.Timeouts, pluginConfig.Retry)
+		if err != nil {
+			diags.AddError("Invalid resource plugin configuration", fmt.Sprintf("Resource plugin %q has an invalid configuration: %s", pluginID, err.Error()))
+			return nil, diags
+		}
+
+		diags.Append(healthCheckPlugin(ctx, p.version, pluginID, plugin, executionConfig)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return []loadedPluginV1{{pluginID: pluginID, plugin: plugin, executionConfig: executionConfig, metadata: metadata}}, diags
+	}
+}
+
+func (p *tfProvider) newAPIV1DataSourcePluginLoader(pluginFactory *pluginv1.Engine, pluginID string, pluginConfig providerDataPluginV1) pluginV1Loader {
+	return func(ctx context.Context) ([]loadedPluginV1, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		plugin, metadata, err := p.loadAPIV1DataSourcePlugin(ctx, pluginFactory, pluginID, pluginConfig)
+		if err != nil {
+			diags.AddError("Error while loading data source plugin", fmt.Sprintf("Could not load data source plugin %q due to an error: %s", pluginID, err.Error()))
+			return nil, diags
+		}
+		executionConfig, err := p.newAPIV1PluginExecutionConfig(pluginConfig.Timeouts, pluginConfig.Retry)
+		if err != nil {
+			diags.AddError("Invalid data source plugin configuration", fmt.Sprintf("Data source plugin %q has an invalid configuration: %s", pluginID, err.Error()))
+			return nil, diags
+		}
+
+		diags.Append(healthCheckPlugin(ctx, p.version, pluginID, plugin, executionConfig)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return []loadedPluginV1{{pluginID: pluginID, plugin: plugin, executionConfig: executionConfig, metadata: metadata}}, diags
 	}
 }
 
-func (p *tfProvider) loadAPIV1ResourcePlugin(ctx context.Context, pluginFactory *pluginv1.Engine, pluginConfig providerDataPluginV1) (apiv1.ResourcePlugin, error) {
+// newAPIV1PluginModuleLoader returns a loader of all the plugins of a plugin module, these are registered as `<module_id>.<name>`.
+func (p *tfProvider) newAPIV1PluginModuleLoader(pluginFactory *pluginv1.Engine, moduleID string, moduleConfig providerDataPluginModuleV1) pluginV1Loader {
+	return func(ctx context.Context) ([]loadedPluginV1, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		plugins, err := p.loadAPIV1PluginModule(ctx, pluginFactory, moduleID, moduleConfig)
+		if err != nil {
+			diags.AddError("Error while loading plugin module", fmt.Sprintf("Could not load plugin module %q due to an error: %s", moduleID, err.Error()))
+			return nil, diags
+		}
+		executionConfig, err := p.newAPIV1PluginExecutionConfig(moduleConfig.Timeouts, moduleConfig.Retry)
+		if err != nil {
+			diags.AddError("Invalid plugin module configuration", fmt.Sprintf("Plugin module %q has an invalid configuration: %s", moduleID, err.Error()))
+			return nil, diags
+		}
+
+		for i, plugin := range plugins {
+			diags.Append(healthCheckPlugin(ctx, p.version, plugin.pluginID, plugin.plugin, executionConfig)...)
+			plugins[i].executionConfig = executionConfig
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return plugins, diags
+	}
+}
+
+func (p *tfProvider) loadAPIV1ResourcePlugin(ctx context.Context, pluginFactory *pluginv1.Engine, pluginID string, pluginConfig providerDataPluginV1) (apiv1.ResourcePlugin, pluginV1Metadata, error) {
 	repo, err := p.loadAPIV1PluginSourceCode(ctx, pluginConfig.SourceCode)
 	if err != nil {
-		return nil, fmt.Errorf("error loading plugin source code: %w", err)
+		return nil, pluginV1Metadata{}, fmt.Errorf("error loading plugin source code: %w", err)
 	}
 
 	// TODO(slok): Remove when plan modifiers are supported on provider configuration attributes.
@@ -240,22 +629,40 @@ func (p *tfProvider) loadAPIV1ResourcePlugin(ctx context.Context, pluginFactory
 		factoryName = apiv1.DefaultResourcePluginFactoryName
 	}
 
+	env, err := p.newAPIV1PluginEnv(pluginConfig.Env, pluginConfig.InheritEnv)
+	if err != nil {
+		return nil, pluginV1Metadata{}, fmt.Errorf("invalid plugin environment: %w", err)
+	}
+
+	processLimits, err := p.newAPIV1PluginProcessLimits(pluginConfig.Execution, pluginConfig.ProcessLimits)
+	if err != nil {
+		return nil, pluginV1Metadata{}, fmt.Errorf("invalid plugin process limits: %w", err)
+	}
+
 	plugin, err := pluginFactory.NewResourcePlugin(ctx, pluginv1.PluginConfig{
 		SourceCodeRepository: repo,
 		PluginFactoryName:    factoryName,
 		PluginOptions:        pluginConfig.Configuration.ValueString(),
+		Stdout:               newPluginStdoutWriter(ctx, pluginID),
+		Stderr:               newPluginStderrWriter(ctx, pluginID),
+		Sandbox:              p.newAPIV1PluginSandboxPolicy(pluginConfig.Sandbox),
+		Env:                  env,
+		Network:              p.newAPIV1PluginNetworkPolicy(pluginID, pluginConfig.AllowedHosts),
+		Filesystem:           p.newAPIV1PluginFilesystemPolicy(pluginID, pluginConfig.Filesystem),
+		Execution:            pluginv1.ExecutionMode(pluginConfig.Execution.ValueString()),
+		ProcessLimits:        processLimits,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("error loading plugin: %w", err)
+		return nil, pluginV1Metadata{}, fmt.Errorf("error loading plugin: %w", err)
 	}
 
-	return plugin, nil
+	return plugin, p.newAPIV1PluginMetadata(ctx, pluginID, pluginKindResource, factoryName, pluginConfig.SourceCode, repo, plugin), nil
 }
 
-func (p *tfProvider) loadAPIV1DataSourcePlugin(ctx context.Context, pluginFactory *pluginv1.Engine, pluginConfig providerDataPluginV1) (apiv1.DataSourcePlugin, error) {
+func (p *tfProvider) loadAPIV1DataSourcePlugin(ctx context.Context, pluginFactory *pluginv1.Engine, pluginID string, pluginConfig providerDataPluginV1) (apiv1.DataSourcePlugin, pluginV1Metadata, error) {
 	repo, err := p.loadAPIV1PluginSourceCode(ctx, pluginConfig.SourceCode)
 	if err != nil {
-		return nil, fmt.Errorf("error loading plugin source code: %w", err)
+		return nil, pluginV1Metadata{}, fmt.Errorf("error loading plugin source code: %w", err)
 	}
 
 	// TODO(slok): Remove when plan modifiers are supported on provider configuration attributes.
@@ -264,16 +671,297 @@ func (p *tfProvider) loadAPIV1DataSourcePlugin(ctx context.Context, pluginFactor
 		factoryName = apiv1.DefaultDataSourcePluginFactoryName
 	}
 
+	env, err := p.newAPIV1PluginEnv(pluginConfig.Env, pluginConfig.InheritEnv)
+	if err != nil {
+		return nil, pluginV1Metadata{}, fmt.Errorf("invalid plugin environment: %w", err)
+	}
+
+	processLimits, err := p.newAPIV1PluginProcessLimits(pluginConfig.Execution, pluginConfig.ProcessLimits)
+	if err != nil {
+		return nil, pluginV1Metadata{}, fmt.Errorf("invalid plugin process limits: %w", err)
+	}
+
 	plugin, err := pluginFactory.NewDataSourcePlugin(ctx, pluginv1.PluginConfig{
 		SourceCodeRepository: repo,
 		PluginFactoryName:    factoryName,
 		PluginOptions:        pluginConfig.Configuration.ValueString(),
+		Stdout:               newPluginStdoutWriter(ctx, pluginID),
+		Stderr:               newPluginStderrWriter(ctx, pluginID),
+		Sandbox:              p.newAPIV1PluginSandboxPolicy(pluginConfig.Sandbox),
+		Env:                  env,
+		Network:              p.newAPIV1PluginNetworkPolicy(pluginID, pluginConfig.AllowedHosts),
+		Filesystem:           p.newAPIV1PluginFilesystemPolicy(pluginID, pluginConfig.Filesystem),
+		Execution:            pluginv1.ExecutionMode(pluginConfig.Execution.ValueString()),
+		ProcessLimits:        processLimits,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("error loading plugin from source code: %w", err)
+		return nil, pluginV1Metadata{}, fmt.Errorf("error loading plugin from source code: %w", err)
+	}
+
+	return plugin, p.newAPIV1PluginMetadata(ctx, pluginID, pluginKindDataSource, factoryName, pluginConfig.SourceCode, repo, plugin), nil
+}
+
+// loadAPIV1PluginModule loads all the plugins of a plugin module, the execution configuration of the loaded
+// plugins is not set.
+func (p *tfProvider) loadAPIV1PluginModule(ctx context.Context, pluginFactory *pluginv1.Engine, moduleID string, moduleConfig providerDataPluginModuleV1) ([]loadedPluginV1, error) {
+	repo, err := p.loadAPIV1PluginSourceCode(ctx, moduleConfig.SourceCode)
+	if err != nil {
+		return nil, fmt.Errorf("error loading plugin module source code: %w", err)
+	}
+
+	// TODO(slok): Remove when plan modifiers are supported on provider configuration attributes.
+	registryName := moduleConfig.RegistryName.ValueString()
+	if registryName == "" {
+		registryName = apiv1.DefaultPluginsRegistryName
+	}
+
+	env, err := p.newAPIV1PluginEnv(moduleConfig.Env, moduleConfig.InheritEnv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plugin module environment: %w", err)
+	}
+
+	processLimits, err := p.newAPIV1PluginProcessLimits(moduleConfig.Execution, moduleConfig.ProcessLimits)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plugin module process limits: %w", err)
+	}
+
+	module, err := pluginFactory.NewPluginModule(ctx, pluginv1.PluginModuleConfig{
+		SourceCodeRepository: repo,
+		PluginsRegistryName:  registryName,
+		PluginOptions:        moduleConfig.Configuration.ValueString(),
+		Stdout:               newPluginStdoutWriter(ctx, moduleID),
+		Stderr:               newPluginStderrWriter(ctx, moduleID),
+		Sandbox:              p.newAPIV1PluginSandboxPolicy(moduleConfig.Sandbox),
+		Env:                  env,
+		Network:              p.newAPIV1PluginNetworkPolicy(moduleID, moduleConfig.AllowedHosts),
+		Filesystem:           p.newAPIV1PluginFilesystemPolicy(moduleID, moduleConfig.Filesystem),
+		Execution:            pluginv1.ExecutionMode(moduleConfig.Execution.ValueString()),
+		ProcessLimits:        processLimits,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error loading plugin module: %w", err)
+	}
+
+	plugins := []loadedPluginV1{}
+	for _, name := range sortedKeys(module.ResourcePlugins) {
+		pluginID := pluginModulePluginID(moduleID, name)
+		plugin := module.ResourcePlugins[name]
+		plugins = append(plugins, loadedPluginV1{
+			pluginID: pluginID,
+			plugin:   plugin,
+			metadata: p.newAPIV1PluginMetadata(ctx, pluginID, pluginKindResource, registryName+"."+name, moduleConfig.SourceCode, repo, plugin),
+		})
+	}
+	for _, name := range sortedKeys(module.DataSourcePlugins) {
+		pluginID := pluginModulePluginID(moduleID, name)
+		plugin := module.DataSourcePlugins[name]
+		plugins = append(plugins, loadedPluginV1{
+			pluginID: pluginID,
+			plugin:   plugin,
+			metadata: p.newAPIV1PluginMetadata(ctx, pluginID, pluginKindDataSource, registryName+"."+name, moduleConfig.SourceCode, repo, plugin),
+		})
+	}
+
+	return plugins, nil
+}
+
+// pluginModulePluginID returns the plugin ID of a plugin registered by a plugin module.
+func pluginModulePluginID(moduleID, name string) string {
+	return moduleID + "." + name
+}
+
+func (p *tfProvider) newAPIV1PluginMetadata(ctx context.Context, pluginID, kind, factoryName string, sourceConfig providerDataPluginV1Source, repo storage.SourceCodeRepository, plugin interface{}) pluginV1Metadata {
+	metadata := pluginV1Metadata{
+		pluginID:    pluginID,
+		kind:        kind,
+		factoryName: factoryName,
+		sourceType:  pluginSourceTypeDir,
+		sourceDir:   sourceConfig.Dir.ValueString(),
+		sourceIndex: repo.Index(ctx),
+	}
+
+	if sourceConfig.Git != nil {
+		// TODO(slok): Remove when plan modifiers are supported on provider configuration attributes.
+		ref := sourceConfig.Git.Ref.ValueString()
+		if ref == "" {
+			ref = "main"
+		}
+
+		metadata.sourceType = pluginSourceTypeGit
+		metadata.sourceDir = sourceConfig.Git.Dir.ValueString()
+		metadata.sourceGitURL = sourceConfig.Git.URL.ValueString()
+		metadata.sourceGitRef = ref
+	}
+
+	// Metadata is optional for the plugins.
+	if pluginv1.Supports(plugin, pluginv1.CapabilityInfoProvider) {
+		metadata.info = plugin.(apiv1.InfoProvider).Info()
+	}
+
+	return metadata
+}
+
+func (p *tfProvider) newAPIV1PluginExecutionConfig(timeouts *providerDataPluginV1Timeouts, retryConfig *providerDataPluginV1Retry) (pluginV1ExecutionConfig, error) {
+	config := pluginV1ExecutionConfig{}
+
+	if timeouts != nil {
+		durations := []struct {
+			attribute string
+			value     types.String
+			duration  *time.Duration
+		}{
+			{attribute: "create", value: timeouts.Create, duration: &config.timeouts.create},
+			{attribute: "read", value: timeouts.Read, duration: &config.timeouts.read},
+			{attribute: "update", value: timeouts.Update, duration: &config.timeouts.update},
+			{attribute: "delete", value: timeouts.Delete, duration: &config.timeouts.delete},
+		}
+		for _, d := range durations {
+			timeout, err := parseDuration(d.value, 0)
+			if err != nil {
+				return config, fmt.Errorf("invalid timeouts %s: %w", d.attribute, err)
+			}
+			*d.duration = timeout
+		}
+	}
+
+	// TODO(slok): Provider config doesn't support plan modifiers, set defaults here until are supported.
+	if retryConfig != nil {
+		initialBackoff, err := parseDuration(retryConfig.InitialBackoff, 1*time.Second)
+		if err != nil {
+			return config, fmt.Errorf("invalid retry initial_backoff: %w", err)
+		}
+
+		maxBackoff, err := parseDuration(retryConfig.MaxBackoff, 30*time.Second)
+		if err != nil {
+			return config, fmt.Errorf("invalid retry max_backoff: %w", err)
+		}
+
+		retry := pluginV1Retry{
+			maxAttempts:    3,
+			initialBackoff: initialBackoff,
+			maxBackoff:     maxBackoff,
+			jitter:         retryConfig.Jitter.ValueFloat64(),
+		}
+
+		if !retryConfig.MaxAttempts.IsNull() {
+			retry.maxAttempts = int(retryConfig.MaxAttempts.ValueInt64())
+		}
+
+		if retry.maxAttempts < 1 {
+			return config, fmt.Errorf("retry max_attempts must be at least 1")
+		}
+
+		if retry.jitter < 0 || retry.jitter > 1 {
+			return config, fmt.Errorf("retry jitter must be between 0 and 1")
+		}
+
+		if retry.maxBackoff > 0 && retry.initialBackoff > retry.maxBackoff {
+			return config, fmt.Errorf("retry initial_backoff can't be greater than max_backoff")
+		}
+
+		config.retry = retry
+	}
+
+	return config, nil
+}
+
+func (p *tfProvider) newAPIV1PluginSandboxPolicy(sandbox *providerDataPluginV1Sandbox) pluginv1.SandboxPolicy {
+	if sandbox == nil {
+		return pluginv1.SandboxPolicy{}
+	}
+
+	return pluginv1.SandboxPolicy{
+		AllowedImports: sandbox.AllowedImports,
+		DeniedImports:  sandbox.DeniedImports,
+		AllowUnsafe:    sandbox.AllowUnsafe.ValueBool(),
+	}
+}
+
+func (p *tfProvider) newAPIV1PluginNetworkPolicy(name string, allowedHosts []string) pluginv1.NetworkPolicy {
+	if allowedHosts == nil {
+		return pluginv1.NetworkPolicy{}
+	}
+
+	return pluginv1.NetworkPolicy{
+		Name:         name,
+		AllowedHosts: allowedHosts,
+	}
+}
+
+func (p *tfProvider) newAPIV1PluginFilesystemPolicy(name string, filesystem *providerDataPluginV1Filesystem) pluginv1.FilesystemPolicy {
+	if filesystem == nil {
+		return pluginv1.FilesystemPolicy{}
+	}
+
+	return pluginv1.FilesystemPolicy{
+		Name:     name,
+		Root:     filesystem.Root.ValueString(),
+		ReadOnly: filesystem.ReadOnly.ValueBool(),
+	}
+}
+
+func (p *tfProvider) newAPIV1PluginProcessLimits(execution types.String, limits *providerDataPluginV1ProcessLimits) (pluginv1.ProcessLimits, error) {
+	if limits == nil {
+		return pluginv1.ProcessLimits{}, nil
+	}
+
+	if pluginv1.ExecutionMode(execution.ValueString()) != pluginv1.ExecutionModeProcess {
+		return pluginv1.ProcessLimits{}, fmt.Errorf("process_limits require process execution")
+	}
+
+	if !limits.MemoryMB.IsNull() && limits.MemoryMB.ValueInt64() < 1 {
+		return pluginv1.ProcessLimits{}, fmt.Errorf("process_limits memory_mb must be at least 1")
+	}
+
+	cpuTime, err := parseDuration(limits.CPUTime, 0)
+	if err != nil {
+		return pluginv1.ProcessLimits{}, fmt.Errorf("invalid process_limits cpu_time: %w", err)
+	}
+
+	return pluginv1.ProcessLimits{
+		CPUTime: cpuTime,
+		Memory:  uint64(limits.MemoryMB.ValueInt64()) * 1024 * 1024,
+	}, nil
+}
+
+// newAPIV1PluginEnv returns the environment of a plugin, the plugin inherits the provider environment variables
+// that match the inherit patterns (none if not set), and then the custom environment variables are set.
+func (p *tfProvider) newAPIV1PluginEnv(env map[string]string, inheritEnv []string) ([]string, error) {
+	for _, pattern := range inheritEnv {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid %q inherit env pattern: %w", pattern, err)
+		}
+	}
+
+	pluginEnv := map[string]string{}
+	for _, kv := range os.Environ() {
+		k, v, _ := strings.Cut(kv, "=")
+		if envNameMatches(k, inheritEnv) {
+			pluginEnv[k] = v
+		}
+	}
+
+	for k, v := range env {
+		pluginEnv[k] = v
+	}
+
+	// Sorted so plugins with the same environment share the same interpreter.
+	result := make([]string, 0, len(pluginEnv))
+	for _, k := range sortedKeys(pluginEnv) {
+		result = append(result, k+"="+pluginEnv[k])
+	}
+
+	return result, nil
+}
+
+func envNameMatches(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
 	}
 
-	return plugin, nil
+	return false
 }
 
 func (p *tfProvider) loadAPIV1PluginSourceCode(ctx context.Context, pluginConfig providerDataPluginV1Source) (storage.SourceCodeRepository, error) {
@@ -1,16 +1,328 @@
 package provider_test
 
 import (
+	"context"
+	"fmt"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
 
+	pluginv1 "github.com/slok/terraform-provider-goplugin/internal/plugin/v1"
 	"github.com/slok/terraform-provider-goplugin/internal/provider"
 )
 
+func TestMain(m *testing.M) {
+	// The test binary is the plugin process binary of the plugins using process execution.
+	if pluginv1.IsPluginProcess() {
+		if err := pluginv1.ServePluginProcess(context.Background()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error running plugin process: %s\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
+var testAccProvider, _ = provider.New(context.Background(), "test")
+
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"goplugin": providerserver.NewProtocol6WithError(provider.New()),
+	"goplugin": providerserver.NewProtocol6WithError(testAccProvider()),
 }
 
 func testAccPreCheck(t *testing.T) {}
+
+// testAccProviderConfig returns a Terraform configuration with the goplugin provider configured with the
+// provider attributes (e.g: the loaded plugins), followed by the resources and data sources configuration.
+func testAccProviderConfig(providerAttributes, config string) string {
+	return fmt.Sprintf(`
+terraform {
+  required_providers {
+    goplugin = {
+      source = "goplugin"
+    }
+  }
+}
+
+provider goplugin {
+%s
+}
+
+%s
+`, providerAttributes, config)
+}
+
+// testAccPluginV1Attributes returns the provider attributes that load a single plugin (e.g: `resource_plugins_v1`)
+// from the source code dir, the plugin attributes (e.g: `retry`) are added to the default plugin configuration.
+func testAccPluginV1Attributes(pluginsAttribute, pluginID, dir, pluginAttributes string) string {
+	return fmt.Sprintf(`
+  %s = {
+    %q = {
+      source_code = {
+        dir = %q
+      }
+      configuration = jsonencode({})
+%s
+    }
+  }
+`, pluginsAttribute, pluginID, dir, pluginAttributes)
+}
+
+// testAccFilePluginConfig returns a Terraform configuration with the `test_file` resource plugin loaded.
+func testAccFilePluginConfig(config string) string {
+	return testAccProviderConfig(testAccPluginV1Attributes("resource_plugins_v1", "test_file", "testdata/file_plugin", ""), config)
+}
+
+// testAccFakeDataSourceConfig returns a Terraform configuration with the `fake` data source plugin loaded
+// using the plugin attributes.
+func testAccFakeDataSourceConfig(pluginAttributes, config string) string {
+	return testAccProviderConfig(testAccPluginV1Attributes("data_source_plugins_v1", "fake", "testdata/fake_data_source", pluginAttributes), config)
+}
+
+func TestNewAPIV1PluginSandboxPolicy(t *testing.T) {
+	allow := true
+
+	tests := map[string]struct {
+		allowedImports []string
+		deniedImports  []string
+		allowUnsafe    *bool
+		expPolicy      pluginv1.SandboxPolicy
+	}{
+		"Without sandbox configuration, the default policy should be used.": {
+			expPolicy: pluginv1.SandboxPolicy{},
+		},
+
+		"Allowed and denied imports should be set on the policy.": {
+			allowedImports: []string{"fmt", "strings"},
+			deniedImports:  []string{"os/exec"},
+			expPolicy: pluginv1.SandboxPolicy{
+				AllowedImports: []string{"fmt", "strings"},
+				DeniedImports:  []string{"os/exec"},
+			},
+		},
+
+		"Unset unsafe should not allow unsafe.": {
+			deniedImports: []string{"net"},
+			expPolicy:     pluginv1.SandboxPolicy{DeniedImports: []string{"net"}},
+		},
+
+		"Allowing unsafe should allow unsafe.": {
+			allowUnsafe: &allow,
+			expPolicy:   pluginv1.SandboxPolicy{AllowUnsafe: true},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			gotPolicy := provider.NewAPIV1PluginSandboxPolicy(test.allowedImports, test.deniedImports, test.allowUnsafe)
+			assert.Equal(test.expPolicy, gotPolicy)
+		})
+	}
+}
+
+func TestNewAPIV1PluginNetworkPolicy(t *testing.T) {
+	tests := map[string]struct {
+		allowedHosts []string
+		expPolicy    pluginv1.NetworkPolicy
+	}{
+		"Without allowed hosts, the network should not be restricted.": {
+			expPolicy: pluginv1.NetworkPolicy{},
+		},
+
+		"Empty allowed hosts should deny all the network.": {
+			allowedHosts: []string{},
+			expPolicy:    pluginv1.NetworkPolicy{Name: "test", AllowedHosts: []string{}},
+		},
+
+		"Allowed hosts should be set on the policy.": {
+			allowedHosts: []string{"api.github.com", "*.example.com:8080"},
+			expPolicy:    pluginv1.NetworkPolicy{Name: "test", AllowedHosts: []string{"api.github.com", "*.example.com:8080"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			gotPolicy := provider.NewAPIV1PluginNetworkPolicy("test", test.allowedHosts)
+			assert.Equal(test.expPolicy, gotPolicy)
+		})
+	}
+}
+
+func TestNewAPIV1PluginFilesystemPolicy(t *testing.T) {
+	root := "/tmp/plugins"
+	readOnly := true
+
+	tests := map[string]struct {
+		root      *string
+		readOnly  *bool
+		expPolicy pluginv1.FilesystemPolicy
+	}{
+		"Without filesystem configuration, the filesystem should not be restricted.": {
+			expPolicy: pluginv1.FilesystemPolicy{},
+		},
+
+		"A root should be set on the policy.": {
+			root:      &root,
+			expPolicy: pluginv1.FilesystemPolicy{Name: "test", Root: "/tmp/plugins"},
+		},
+
+		"Read-only should be set on the policy.": {
+			readOnly:  &readOnly,
+			expPolicy: pluginv1.FilesystemPolicy{Name: "test", ReadOnly: true},
+		},
+
+		"A root and read-only should be set on the policy.": {
+			root:      &root,
+			readOnly:  &readOnly,
+			expPolicy: pluginv1.FilesystemPolicy{Name: "test", Root: "/tmp/plugins", ReadOnly: true},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			gotPolicy := provider.NewAPIV1PluginFilesystemPolicy("test", test.root, test.readOnly)
+			assert.Equal(test.expPolicy, gotPolicy)
+		})
+	}
+}
+
+func TestNewAPIV1PluginDurations(t *testing.T) {
+	tests := map[string]struct {
+		readTimeout    string
+		initialBackoff string
+		maxBackoff     string
+		cpuTime        string
+		expDurations   provider.PluginV1Durations
+		expErr         string
+	}{
+		"Without durations, the defaults should be used.": {
+			expDurations: provider.PluginV1Durations{InitialBackoff: time.Second, MaxBackoff: 30 * time.Second},
+		},
+
+		"The durations should be parsed.": {
+			readTimeout:    "1m",
+			initialBackoff: "2s",
+			maxBackoff:     "1h",
+			cpuTime:        "5s",
+			expDurations: provider.PluginV1Durations{
+				ReadTimeout:    time.Minute,
+				InitialBackoff: 2 * time.Second,
+				MaxBackoff:     time.Hour,
+				CPUTime:        5 * time.Second,
+			},
+		},
+
+		"An invalid timeout should fail with the timeout attribute.": {
+			readTimeout: "wrong",
+			expErr:      "invalid timeouts read: ",
+		},
+
+		"An invalid initial backoff should fail with the retry attribute.": {
+			initialBackoff: "wrong",
+			expErr:         "invalid retry initial_backoff: ",
+		},
+
+		"An invalid max backoff should fail with the retry attribute.": {
+			maxBackoff: "wrong",
+			expErr:     "invalid retry max_backoff: ",
+		},
+
+		"An invalid CPU time should fail with the process limits attribute.": {
+			cpuTime: "wrong",
+			expErr:  "invalid process_limits cpu_time: ",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			gotDurations, err := provider.NewAPIV1PluginDurations(test.readTimeout, test.initialBackoff, test.maxBackoff, test.cpuTime)
+
+			if test.expErr != "" {
+				if assert.Error(err) {
+					assert.Contains(err.Error(), test.expErr)
+				}
+			} else if assert.NoError(err) {
+				assert.Equal(test.expDurations, gotDurations)
+			}
+		})
+	}
+}
+
+func TestNewAPIV1PluginEnv(t *testing.T) {
+	t.Setenv("TEST_GOPLUGIN_A", "a")
+	t.Setenv("TEST_GOPLUGIN_B", "b")
+	t.Setenv("TEST_OTHER", "other")
+
+	tests := map[string]struct {
+		env        map[string]string
+		inheritEnv []string
+		expEnv     []string
+		expErr     bool
+	}{
+		"Without inherit patterns, the plugin should not inherit the provider environment.": {
+			env:    map[string]string{"CUSTOM": "custom"},
+			expEnv: []string{"CUSTOM=custom"},
+		},
+
+		"Without inherit patterns nor custom environment, the environment should be empty.": {
+			expEnv: []string{},
+		},
+
+		"An empty list of inherit patterns should not inherit the provider environment.": {
+			inheritEnv: []string{},
+			expEnv:     []string{},
+		},
+
+		"The provider environment matching the inherit patterns should be inherited.": {
+			inheritEnv: []string{"TEST_GOPLUGIN_*", "TEST_OTHER"},
+			expEnv:     []string{"TEST_GOPLUGIN_A=a", "TEST_GOPLUGIN_B=b", "TEST_OTHER=other"},
+		},
+
+		"The custom environment should have priority over the inherited one.": {
+			env:        map[string]string{"TEST_GOPLUGIN_A": "custom", "CUSTOM": "custom"},
+			inheritEnv: []string{"TEST_GOPLUGIN_*"},
+			expEnv:     []string{"CUSTOM=custom", "TEST_GOPLUGIN_A=custom", "TEST_GOPLUGIN_B=b"},
+		},
+
+		"An invalid inherit pattern should fail.": {
+			inheritEnv: []string{"TEST_["},
+			expErr:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			gotEnv, err := provider.NewAPIV1PluginEnv(test.env, test.inheritEnv)
+
+			if test.expErr {
+				assert.Error(err)
+			} else if assert.NoError(err) {
+				assert.Equal(test.expEnv, gotEnv)
+			}
+		})
+	}
+}
+
+func TestNewAPIV1PluginEnvInheritAll(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv("TEST_GOPLUGIN_A", "a")
+
+	gotEnv, err := provider.NewAPIV1PluginEnv(nil, []string{"*"})
+	assert.NoError(err)
+	assert.Contains(gotEnv, "TEST_GOPLUGIN_A=a")
+	assert.Len(gotEnv, len(os.Environ()))
+}
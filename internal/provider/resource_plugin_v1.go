@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -10,7 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	pluginv1 "github.com/slok/terraform-provider-goplugin/internal/plugin/v1"
 	"github.com/slok/terraform-provider-goplugin/internal/provider/attributeutils"
 	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
 )
@@ -20,11 +25,14 @@ func newResourcePluginV1() resource.Resource {
 }
 
 type resourcePluginV1 struct {
-	plugins map[string]apiv1.ResourcePlugin
+	providerVersion  string
+	plugins          map[string]apiv1.ResourcePlugin
+	executionConfigs map[string]pluginV1ExecutionConfig
 }
 
 func (r *resourcePluginV1) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
+		Version: 1,
 		Description: `
 Executes a Resource Go plugin v1.
 
@@ -68,12 +76,95 @@ Check [examples](https://github.com/slok/terraform-provider-goplugin/tree/main/e
 				Validators:    []tfsdk.AttributeValidator{attributeutils.NonEmptyString, attributeutils.MustJSONObject},
 				Required:      true,
 			},
+			"attributes_version": {
+				Description: `The version of the attributes format used by the plugin, plugins can upgrade the attributes
+								stored on the state from older versions when the resource is refreshed.`,
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+			"timeouts": {
+				Optional:    true,
+				Description: `Timeouts of the plugin operations (e.g: ` + "`30s`, `5m`, `1h`" + `), if not set, the plugin default timeouts configured on the provider will be used.`,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"create": {
+						Optional:    true,
+						Description: `Timeout of the create operation.`,
+						Validators:  []tfsdk.AttributeValidator{attributeutils.MustDuration},
+						Type:        types.StringType,
+					},
+					"read": {
+						Optional:    true,
+						Description: `Timeout of the read operation, also used by the plan, validation and attributes upgrade operations.`,
+						Validators:  []tfsdk.AttributeValidator{attributeutils.MustDuration},
+						Type:        types.StringType,
+					},
+					"update": {
+						Optional:    true,
+						Description: `Timeout of the update operation.`,
+						Validators:  []tfsdk.AttributeValidator{attributeutils.MustDuration},
+						Type:        types.StringType,
+					},
+					"delete": {
+						Optional:    true,
+						Description: `Timeout of the delete operation.`,
+						Validators:  []tfsdk.AttributeValidator{attributeutils.MustDuration},
+						Type:        types.StringType,
+					},
+				}),
+			},
+			"outputs": {
+				Description: `A JSON string with the computed data returned by the plugin resource (E.g URLs, ARNs,
+								timestamps...), this data is only known after the plugin creates or updates the resource.
+								It can be decoded with ` + "`jsondecode`" + ` and referenced by other resources.`,
+				Type:     types.StringType,
+				Computed: true,
+			},
 		},
 	}, nil
 }
 
+func (r *resourcePluginV1) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"id":          {Type: types.StringType, Computed: true},
+					"resource_id": {Type: types.StringType, Computed: true},
+					"plugin_id":   {Type: types.StringType, Required: true},
+					"attributes":  {Type: types.StringType, Required: true},
+					"outputs":     {Type: types.StringType, Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var tfResourceStateV0 ResourcePluginV1V0
+				diags := req.State.Get(ctx, &tfResourceStateV0)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Before versioning, all the attributes were version 0.
+				newTfPluginV1 := ResourcePluginV1{
+					ID:                tfResourceStateV0.ID,
+					ResourceID:        tfResourceStateV0.ResourceID,
+					PluginID:          tfResourceStateV0.PluginID,
+					Attributes:        tfResourceStateV0.Attributes,
+					AttributesVersion: types.Int64Value(0),
+					Outputs:           tfResourceStateV0.Outputs,
+				}
+
+				diags = resp.State.Set(ctx, newTfPluginV1)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+			},
+		},
+	}
+}
+
 func (r *resourcePluginV1) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_plugin_v1"
+	resp.TypeName = pluginV1TypeName
 }
 
 func (r *resourcePluginV1) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -92,6 +183,8 @@ func (r *resourcePluginV1) Configure(ctx context.Context, req resource.Configure
 	}
 
 	r.plugins = rd.plugins
+	r.executionConfigs = rd.executionConfigs
+	r.providerVersion = rd.providerVersion
 }
 
 func (r *resourcePluginV1) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -111,9 +204,19 @@ func (r *resourcePluginV1) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Execute plugin.
-	pluginResp, err := plugin.CreateResource(ctx, apiv1.CreateResourceRequest{Attributes: tfResourcePlan.Attributes.ValueString()})
+	ctx = r.pluginOperationContext(ctx, tfResourcePlan.PluginID.ValueString(), apiv1.OperationCreate, "")
+	executionConfig := r.executionConfigs[tfResourcePlan.PluginID.ValueString()]
+	timeout := operationTimeout(tfResourcePlan.Timeouts.createTimeout(), executionConfig.timeouts.create)
+	pluginResp, err := runPluginOperation(ctx, timeout, executionConfig.retry, func(ctx context.Context) (*apiv1.CreateResourceResponse, error) {
+		return plugin.CreateResource(ctx, apiv1.CreateResourceRequest{Attributes: tfResourcePlan.Attributes.ValueString()})
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error executing plugin", "Plugin execution end in error: "+err.Error())
+		resp.Diagnostics.Append(pluginErrorDiagnostic(err))
+		return
+	}
+
+	resp.Diagnostics.Append(pluginDiagnostics(pluginResp.Diagnostics)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -127,10 +230,19 @@ func (r *resourcePluginV1) Create(ctx context.Context, req resource.CreateReques
 
 	// Map result.
 	newTfPluginV1 := ResourcePluginV1{
-		ID:         types.StringValue(id),
-		ResourceID: types.StringValue(pluginResp.ID),
-		PluginID:   tfResourcePlan.PluginID,
-		Attributes: tfResourcePlan.Attributes,
+		ID:                types.StringValue(id),
+		ResourceID:        types.StringValue(pluginResp.ID),
+		PluginID:          tfResourcePlan.PluginID,
+		Attributes:        tfResourcePlan.Attributes,
+		AttributesVersion: types.Int64Value(r.attributesVersion(plugin)),
+		Outputs:           r.outputsValue(pluginResp.Outputs, types.StringNull()),
+		Timeouts:          tfResourcePlan.Timeouts,
+	}
+
+	diags = setPluginPrivate(ctx, resp.Private, pluginResp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	diags = resp.State.Set(ctx, newTfPluginV1)
@@ -162,20 +274,62 @@ func (r *resourcePluginV1) Read(ctx context.Context, req resource.ReadRequest, r
 		resp.Diagnostics.AddError("Plugin missing", fmt.Sprintf("%q plugin is not loaded", pluginID))
 		return
 	}
+	ctx = r.pluginOperationContext(ctx, pluginID, apiv1.OperationRead, resourceID)
+
+	// Retrieve plugin private data.
+	private, diags := getPluginPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Upgrade the state attributes if they are from an older version.
+	attributesState, diags := r.upgradeAttributes(ctx, plugin, tfResourceState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Execute plugin.
-	pluginResp, err := plugin.ReadResource(ctx, apiv1.ReadResourceRequest{ID: resourceID})
+	timeout := operationTimeout(tfResourceState.Timeouts.readTimeout(), r.executionConfigs[pluginID].timeouts.read)
+	pluginResp, err := runPluginOperation(ctx, timeout, r.executionConfigs[pluginID].retry, func(ctx context.Context) (*apiv1.ReadResourceResponse, error) {
+		return plugin.ReadResource(ctx, apiv1.ReadResourceRequest{
+			ID:              resourceID,
+			AttributesState: attributesState,
+			Private:         private,
+		})
+	})
+	if errors.Is(err, apiv1.ErrNotFound) {
+		// The resource has been deleted outside Terraform, remove from the state so it can be recreated.
+		tflog.Warn(ctx, "Resource not found, removing from state", map[string]interface{}{"id": tfResourceState.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error executing plugin", "Plugin execution end in error: "+err.Error())
+		resp.Diagnostics.Append(pluginErrorDiagnostic(err))
+		return
+	}
+
+	resp.Diagnostics.Append(pluginDiagnostics(pluginResp.Diagnostics)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Map result.
 	newTfPluginV1 := ResourcePluginV1{
-		ID:         tfResourceState.ID,
-		ResourceID: types.StringValue(resourceID),
-		PluginID:   types.StringValue(pluginID),
-		Attributes: types.StringValue(pluginResp.Attributes),
+		ID:                tfResourceState.ID,
+		ResourceID:        types.StringValue(resourceID),
+		PluginID:          types.StringValue(pluginID),
+		Attributes:        types.StringValue(pluginResp.Attributes),
+		AttributesVersion: types.Int64Value(r.attributesVersion(plugin)),
+		Outputs:           r.outputsValue(pluginResp.Outputs, tfResourceState.Outputs),
+		Timeouts:          tfResourceState.Timeouts,
+	}
+
+	diags = setPluginPrivate(ctx, resp.Private, pluginResp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	diags = resp.State.Set(ctx, newTfPluginV1)
@@ -202,6 +356,14 @@ func (r *resourcePluginV1) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// If only the timeouts have changed, there is nothing to update on the plugin.
+	if tfResourcePlan.PluginID.Equal(tfResourceState.PluginID) && tfResourcePlan.Attributes.Equal(tfResourceState.Attributes) {
+		tfResourceState.Timeouts = tfResourcePlan.Timeouts
+		diags = resp.State.Set(ctx, tfResourceState)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	// Unpack ID.
 	pluginID, resourceID, err := r.unpackID(tfResourceState.ID.ValueString())
 	if err != nil {
@@ -215,24 +377,50 @@ func (r *resourcePluginV1) Update(ctx context.Context, req resource.UpdateReques
 		resp.Diagnostics.AddError("Plugin missing", fmt.Sprintf("%q plugin is not loaded", pluginID))
 		return
 	}
+	ctx = r.pluginOperationContext(ctx, pluginID, apiv1.OperationUpdate, resourceID)
+
+	// Retrieve plugin private data.
+	private, diags := getPluginPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Execute plugin.
-	_, err = plugin.UpdateResource(ctx, apiv1.UpdateResourceRequest{
-		ID:              resourceID,
-		Attributes:      tfResourcePlan.Attributes.ValueString(),
-		AttributesState: tfResourceState.Attributes.ValueString(),
+	timeout := operationTimeout(tfResourcePlan.Timeouts.updateTimeout(), r.executionConfigs[pluginID].timeouts.update)
+	pluginResp, err := runPluginOperation(ctx, timeout, r.executionConfigs[pluginID].retry, func(ctx context.Context) (*apiv1.UpdateResourceResponse, error) {
+		return plugin.UpdateResource(ctx, apiv1.UpdateResourceRequest{
+			ID:              resourceID,
+			Attributes:      tfResourcePlan.Attributes.ValueString(),
+			AttributesState: tfResourceState.Attributes.ValueString(),
+			Private:         private,
+		})
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error executing plugin", "Plugin execution end in error: "+err.Error())
+		resp.Diagnostics.Append(pluginErrorDiagnostic(err))
+		return
+	}
+
+	resp.Diagnostics.Append(pluginDiagnostics(pluginResp.Diagnostics)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Map result.
 	newTfPluginV1 := ResourcePluginV1{
-		ID:         tfResourceState.ID,         // Once on state, never changes.
-		ResourceID: tfResourceState.ResourceID, // Once on state, never changes.
-		PluginID:   tfResourcePlan.PluginID,
-		Attributes: tfResourcePlan.Attributes,
+		ID:                tfResourceState.ID,         // Once on state, never changes.
+		ResourceID:        tfResourceState.ResourceID, // Once on state, never changes.
+		PluginID:          tfResourcePlan.PluginID,
+		Attributes:        tfResourcePlan.Attributes,
+		AttributesVersion: types.Int64Value(r.attributesVersion(plugin)),
+		Outputs:           r.outputsValue(pluginResp.Outputs, tfResourceState.Outputs),
+		Timeouts:          tfResourcePlan.Timeouts,
+	}
+
+	diags = setPluginPrivate(ctx, resp.Private, pluginResp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	diags = resp.State.Set(ctx, newTfPluginV1)
@@ -264,11 +452,36 @@ func (r *resourcePluginV1) Delete(ctx context.Context, req resource.DeleteReques
 		resp.Diagnostics.AddError("Plugin missing", fmt.Sprintf("%q plugin is not loaded", pluginID))
 		return
 	}
+	ctx = r.pluginOperationContext(ctx, pluginID, apiv1.OperationDelete, resourceID)
+
+	// Retrieve plugin private data.
+	private, diags := getPluginPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Execute plugin.
-	_, err = plugin.DeleteResource(ctx, apiv1.DeleteResourceRequest{ID: resourceID})
+	timeout := operationTimeout(tfResourceState.Timeouts.deleteTimeout(), r.executionConfigs[pluginID].timeouts.delete)
+	pluginResp, err := runPluginOperation(ctx, timeout, r.executionConfigs[pluginID].retry, func(ctx context.Context) (*apiv1.DeleteResourceResponse, error) {
+		return plugin.DeleteResource(ctx, apiv1.DeleteResourceRequest{
+			ID:              resourceID,
+			AttributesState: tfResourceState.Attributes.ValueString(),
+			Private:         private,
+		})
+	})
+	if errors.Is(err, apiv1.ErrNotFound) {
+		// Already deleted outside Terraform.
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error executing plugin", "Plugin execution end in error: "+err.Error())
+		resp.Diagnostics.Append(pluginErrorDiagnostic(err))
+		return
+	}
+
+	resp.Diagnostics.Append(pluginDiagnostics(pluginResp.Diagnostics)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -276,9 +489,319 @@ func (r *resourcePluginV1) Delete(ctx context.Context, req resource.DeleteReques
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *resourcePluginV1) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Retrieve values from config.
+	var tfConfig ResourcePluginV1
+	diags := req.Config.Get(ctx, &tfConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We can only validate when we know the values.
+	if tfConfig.PluginID.IsUnknown() || tfConfig.PluginID.IsNull() || tfConfig.Attributes.IsUnknown() || tfConfig.Attributes.IsNull() {
+		return
+	}
+
+	// Plugins are not loaded if the provider is not configured (e.g: `terraform validate`), missing plugins will be
+	// reported when executing the plugin operation.
+	plugin, ok := r.plugins[tfConfig.PluginID.ValueString()]
+	if !ok {
+		return
+	}
+
+	ctx = r.pluginOperationContext(ctx, tfConfig.PluginID.ValueString(), apiv1.OperationValidate, "")
+	executionConfig := r.executionConfigs[tfConfig.PluginID.ValueString()]
+	timeout := operationTimeout(tfConfig.Timeouts.readTimeout(), executionConfig.timeouts.read)
+	resp.Diagnostics.Append(validatePluginAttributes(ctx, plugin, timeout, executionConfig.retry, tfConfig.Attributes.ValueString())...)
+}
+
+func (r *resourcePluginV1) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is going to be deleted.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Retrieve values from plan.
+	var tfResourcePlan ResourcePluginV1
+	diags := req.Plan.Get(ctx, &tfResourcePlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If we don't know the values yet, Terraform will ask for a plan again on apply with the final values.
+	if tfResourcePlan.PluginID.IsUnknown() || tfResourcePlan.Attributes.IsUnknown() {
+		return
+	}
+
+	// Retrieve state values (if the resource is already created).
+	creating := req.State.Raw.IsNull()
+	var tfResourceState ResourcePluginV1
+	if !creating {
+		diags = req.State.Get(ctx, &tfResourceState)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ctx = r.pluginOperationContext(ctx, tfResourcePlan.PluginID.ValueString(), apiv1.OperationPlan, tfResourceState.ResourceID.ValueString())
+
+	// Planning plugin operations use the read timeout.
+	executionConfig := r.executionConfigs[tfResourcePlan.PluginID.ValueString()]
+	timeout := operationTimeout(tfResourcePlan.Timeouts.readTimeout(), executionConfig.timeouts.read)
+
+	// Semantic comparison is optional for the plugins, missing plugins will be reported when executing the plugin operation.
+	plugin := r.plugins[tfResourcePlan.PluginID.ValueString()]
+	if pluginv1.Supports(plugin, pluginv1.CapabilityAttributesComparator) && !creating && !tfResourcePlan.Attributes.Equal(tfResourceState.Attributes) {
+		// Execute plugin.
+		pluginResp, err := runPluginOperation(ctx, timeout, executionConfig.retry, func(ctx context.Context) (*apiv1.AttributesEqualResponse, error) {
+			return plugin.(apiv1.AttributesComparator).AttributesEqual(ctx, apiv1.AttributesEqualRequest{
+				Attributes:      tfResourcePlan.Attributes.ValueString(),
+				AttributesState: tfResourceState.Attributes.ValueString(),
+			})
+		})
+		if err != nil {
+			resp.Diagnostics.Append(pluginErrorDiagnostic(err))
+			return
+		}
+
+		resp.Diagnostics.Append(pluginDiagnostics(pluginResp.Diagnostics)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// If equal, plan the state attributes so we don't have changes.
+		if pluginResp.Equal {
+			tfResourcePlan.Attributes = tfResourceState.Attributes
+			diags = resp.Plan.SetAttribute(ctx, path.Root("attributes"), tfResourceState.Attributes)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	// Planning is optional for the plugins, missing plugins will be reported when executing the plugin operation.
+	if pluginv1.Supports(plugin, pluginv1.CapabilityResourcePlanner) {
+		// Retrieve plugin private data.
+		private, diags := getPluginPrivate(ctx, req.Private)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Execute plugin.
+		pluginResp, err := runPluginOperation(ctx, timeout, executionConfig.retry, func(ctx context.Context) (*apiv1.PlanResourceResponse, error) {
+			return plugin.(apiv1.ResourcePlanner).PlanResource(ctx, apiv1.PlanResourceRequest{
+				ID:              tfResourceState.ResourceID.ValueString(),
+				Attributes:      tfResourcePlan.Attributes.ValueString(),
+				AttributesState: tfResourceState.Attributes.ValueString(),
+				Private:         private,
+			})
+		})
+		if err != nil {
+			resp.Diagnostics.Append(pluginErrorDiagnostic(err))
+			return
+		}
+
+		resp.Diagnostics.Append(pluginDiagnostics(pluginResp.Diagnostics)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Terraform only allows planning the configuration or the state attributes, so if the plugin
+		// normalized attributes are the same as the state ones, we don't have changes.
+		if !creating && pluginResp.Attributes != "" && attributeutils.IsEquivalentJSON(pluginResp.Attributes, tfResourceState.Attributes.ValueString()) {
+			tfResourcePlan.Attributes = tfResourceState.Attributes
+			diags = resp.Plan.SetAttribute(ctx, path.Root("attributes"), tfResourceState.Attributes)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		replacePaths, diags := pluginRequiresReplace(pluginResp.RequiresReplace)
+		resp.Diagnostics.Append(diags...)
+		resp.RequiresReplace = append(resp.RequiresReplace, replacePaths...)
+	}
+
+	// Outputs are only known after applying, so if the resource is not going to change, we already know them.
+	unchanged := !creating && tfResourcePlan.PluginID.Equal(tfResourceState.PluginID) && tfResourcePlan.Attributes.Equal(tfResourceState.Attributes)
+	if unchanged {
+		diags = resp.Plan.SetAttribute(ctx, path.Root("outputs"), tfResourceState.Outputs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The attributes version will be the plugin one after applying, if not changed, the state one is kept.
+	attributesVersion := tfResourceState.AttributesVersion
+	if !unchanged {
+		plugin, ok := r.plugins[tfResourcePlan.PluginID.ValueString()]
+		if !ok {
+			return
+		}
+		attributesVersion = types.Int64Value(r.attributesVersion(plugin))
+	}
+	diags = resp.Plan.SetAttribute(ctx, path.Root("attributes_version"), attributesVersion)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
 func (r *resourcePluginV1) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Save the import identifier in the id attribute.
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Unpack ID.
+	pluginID, importID, err := r.unpackID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("ID is wrong", fmt.Sprintf("%q id is wrong: %s", req.ID, err))
+		return
+	}
+
+	// Importing is optional for the plugins, by default the import identifier is used as the ID.
+	if !pluginv1.Supports(r.plugins[pluginID], pluginv1.CapabilityResourceImporter) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	importer := r.plugins[pluginID].(apiv1.ResourceImporter)
+
+	// Execute plugin, imports use the read timeout.
+	ctx = r.pluginOperationContext(ctx, pluginID, apiv1.OperationImport, importID)
+	executionConfig := r.executionConfigs[pluginID]
+	pluginResp, err := runPluginOperation(ctx, executionConfig.timeouts.read, executionConfig.retry, func(ctx context.Context) (*apiv1.ImportResourceResponse, error) {
+		return importer.ImportResource(ctx, apiv1.ImportResourceRequest{ID: importID})
+	})
+	if err != nil {
+		resp.Diagnostics.Append(pluginErrorDiagnostic(err))
+		return
+	}
+
+	resp.Diagnostics.Append(pluginDiagnostics(pluginResp.Diagnostics)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if pluginResp.ID == "" {
+		resp.Diagnostics.AddError("Plugin didn't return ID", fmt.Sprintf("On resource import the plugin %q must return an ID, it didn't.", pluginID))
+		return
+	}
+
+	// Save the canonical ID, the rest of the data will be obtained on the read operation.
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), r.packID(pluginID, pluginResp.ID))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if pluginResp.Attributes != "" {
+		diags = resp.State.SetAttribute(ctx, path.Root("attributes"), pluginResp.Attributes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// pluginOperationContext returns the context for the plugin operations of the resource.
+func (r *resourcePluginV1) pluginOperationContext(ctx context.Context, pluginID, operation, resourceID string) context.Context {
+	return pluginOperationContext(ctx, apiv1.RequestInfo{
+		PluginID:        pluginID,
+		Operation:       operation,
+		ResourceType:    pluginV1TypeName,
+		ResourceID:      resourceID,
+		Workspace:       os.Getenv("TF_WORKSPACE"),
+		ProviderVersion: r.providerVersion,
+	})
+}
+
+// attributesVersion returns the current attributes version of the plugin.
+func (r *resourcePluginV1) attributesVersion(plugin apiv1.ResourcePlugin) int64 {
+	if !pluginv1.Supports(plugin, pluginv1.CapabilityAttributesUpgrader) {
+		return 0
+	}
+
+	return int64(plugin.(apiv1.AttributesUpgrader).AttributesVersion())
+}
+
+// upgradeAttributes returns the state attributes upgraded to the current plugin attributes version, the upgrade
+// uses the read timeout.
+func (r *resourcePluginV1) upgradeAttributes(ctx context.Context, plugin apiv1.ResourcePlugin, tfResourceState ResourcePluginV1) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	attributes := tfResourceState.Attributes.ValueString()
+
+	// Without version (e.g: imports) we don't know the version of the attributes.
+	if tfResourceState.AttributesVersion.IsNull() || tfResourceState.AttributesVersion.IsUnknown() {
+		return attributes, diags
+	}
+
+	currentVersion := r.attributesVersion(plugin)
+	stateVersion := tfResourceState.AttributesVersion.ValueInt64()
+	switch {
+	case stateVersion == currentVersion:
+		return attributes, diags
+	case stateVersion > currentVersion:
+		diags.AddError("Invalid attributes version", fmt.Sprintf("State attributes version (%d) is newer than the plugin attributes version (%d)", stateVersion, currentVersion))
+		return "", diags
+	}
+
+	tflog.Info(ctx, "Upgrading resource attributes", map[string]interface{}{"from": stateVersion, "to": currentVersion})
+	executionConfig := r.executionConfigs[tfResourceState.PluginID.ValueString()]
+	timeout := operationTimeout(tfResourceState.Timeouts.readTimeout(), executionConfig.timeouts.read)
+	pluginResp, err := runPluginOperation(ctx, timeout, executionConfig.retry, func(ctx context.Context) (*apiv1.UpgradeAttributesResponse, error) {
+		return plugin.(apiv1.AttributesUpgrader).UpgradeAttributes(ctx, apiv1.UpgradeAttributesRequest{
+			FromVersion: int(stateVersion),
+			Attributes:  attributes,
+		})
+	})
+	if err != nil {
+		diags.Append(pluginErrorDiagnostic(err))
+		return "", diags
+	}
+
+	diags.Append(pluginDiagnostics(pluginResp.Diagnostics)...)
+
+	return pluginResp.Attributes, diags
+}
+
+// pluginRequiresReplace returns the Terraform attribute paths that require the resource replacement based on the
+// plugin attribute paths.
+//
+// All the plugin attributes are on a single Terraform attribute, so Terraform would only show that `attributes`
+// forces the replacement, a warning with the plugin attribute paths is returned to show the user what triggered it.
+func pluginRequiresReplace(pluginPaths []string) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	paths := []string{}
+	seen := map[string]bool{}
+	for _, p := range pluginPaths {
+		if p == "" || seen[p] {
+			continue
+		}
+		seen[p] = true
+		paths = append(paths, strconv.Quote(p))
+	}
+
+	if len(paths) == 0 {
+		return nil, diags
+	}
+
+	diags.AddAttributeWarning(path.Root("attributes"), "Plugin requires resource replacement",
+		fmt.Sprintf("The resource will be replaced because these attributes can't be updated in place: %s", strings.Join(paths, ", ")))
+
+	return path.Paths{path.Root("attributes")}, diags
+}
+
+// outputsValue returns the Terraform value of the plugin outputs, if the plugin didn't return outputs
+// the default value will be used.
+func (r *resourcePluginV1) outputsValue(outputs string, defaultValue types.String) types.String {
+	if outputs == "" {
+		return defaultValue
+	}
+
+	return types.StringValue(outputs)
 }
 
 func (r *resourcePluginV1) packID(pluginID, resourceID string) string {
@@ -2,44 +2,25 @@ package provider_test
 
 import (
 	"errors"
-	"fmt"
 	"os"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
-)
-
-const providerConfigFmt = `
-terraform {
-  required_providers {
-    goplugin = {
-      source = "goplugin"
-    }
-  }
-}
 
-provider goplugin { 
-  resource_plugins_v1 = {
-    "test_file": {
-      source_code = {
-		dir = "testdata/file_plugin"
-	  }
-      configuration =  jsonencode({})
-    }
-  }
-}
-
-%s
-`
+	"github.com/slok/terraform-provider-goplugin/internal/provider"
+)
 
 type expTestResourcePluginv1 struct {
 	ID         string
 	ResourceID string
 	PluginID   string
 	Attributes string
+	Outputs    string
 }
 
 func assertFileMissing(t *testing.T, file string) resource.TestCheckFunc {
@@ -96,6 +77,31 @@ resource "goplugin_plugin_v1" "test" {
 			expErr: regexp.MustCompile(`Attribute must be JSON object`),
 		},
 
+		"A plugin returning error diagnostics should fail.": {
+			config: `
+resource "goplugin_plugin_v1" "test" {
+  plugin_id = "test_file"
+  attributes = jsonencode({
+    path = "tmp/test.txt"
+    content = "this is a test"
+  })
+}
+`,
+			expErr: regexp.MustCompile(`Attribute "path": Path must be absolute`),
+		},
+
+		"A plugin failing the attributes validation should fail.": {
+			config: `
+resource "goplugin_plugin_v1" "test" {
+  plugin_id = "test_file"
+  attributes = jsonencode({
+    content = "this is a test"
+  })
+}
+`,
+			expErr: regexp.MustCompile(`Attribute "path": Path is required`),
+		},
+
 		"A correct configuration should execute correctly.": {
 			config: `
 resource "goplugin_plugin_v1" "test" {
@@ -111,6 +117,7 @@ resource "goplugin_plugin_v1" "test" {
 				ResourceID: `/tmp/test.txt`,
 				PluginID:   `test_file`,
 				Attributes: `{"content":"this is a test","path":"/tmp/test.txt"}`,
+				Outputs:    `{"size":14}`,
 			},
 			expFile:        "/tmp/test.txt",
 			expFileContent: "this is a test",
@@ -127,12 +134,14 @@ resource "goplugin_plugin_v1" "test" {
 					resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "resource_id", test.expState.ResourceID),
 					resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "plugin_id", test.expState.PluginID),
 					resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "attributes", test.expState.Attributes),
+					resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "attributes_version", "0"),
+					resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "outputs", test.expState.Outputs),
 					assertFileExistsWithContent(t, test.expFile, test.expFileContent),
 				)
 			}
 
 			// Assemble our Terraform config code.
-			config := fmt.Sprintf(providerConfigFmt, test.config)
+			config := testAccFilePluginConfig(test.config)
 
 			// Execute test.
 			resource.Test(t, resource.TestCase{
@@ -189,6 +198,7 @@ resource "goplugin_plugin_v1" "test" {
 				ResourceID: `/tmp/test.txt`,
 				PluginID:   `test_file`,
 				Attributes: `{"content":"this is a test","path":"/tmp/test.txt"}`,
+				Outputs:    `{"size":14}`,
 			},
 			expFile:              "/tmp/test.txt",
 			expFileContentCreate: "is this a test?",
@@ -199,8 +209,8 @@ resource "goplugin_plugin_v1" "test" {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			// Assemble our Terraform config code.
-			configCreate := fmt.Sprintf(providerConfigFmt, test.configCreate)
-			configUpdate := fmt.Sprintf(providerConfigFmt, test.configUpdate)
+			configCreate := testAccFilePluginConfig(test.configCreate)
+			configUpdate := testAccFilePluginConfig(test.configUpdate)
 
 			// Execute test.
 			resource.Test(t, resource.TestCase{
@@ -220,6 +230,12 @@ resource "goplugin_plugin_v1" "test" {
 						ImportState:       true,
 						ImportStateVerify: true,
 					},
+					{
+						ResourceName:      "goplugin_plugin_v1.test",
+						ImportState:       true,
+						ImportStateId:     "test_file/file://" + test.expFile,
+						ImportStateVerify: true,
+					},
 					{
 						Config: configUpdate,
 						Check: resource.ComposeAggregateTestCheckFunc(
@@ -227,6 +243,7 @@ resource "goplugin_plugin_v1" "test" {
 							resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "resource_id", test.expState.ResourceID),
 							resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "plugin_id", test.expState.PluginID),
 							resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "attributes", test.expState.Attributes),
+							resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "outputs", test.expState.Outputs),
 							assertFileExistsWithContent(t, test.expFile, test.expFileContentUpdate),
 						),
 					},
@@ -235,3 +252,229 @@ resource "goplugin_plugin_v1" "test" {
 		})
 	}
 }
+
+// TestAccResourcePlugingV1Replace will check a plugin can force the replacement of a resource on the plan.
+// This test relies on a test plugin that knows how to manage a file a terraform resource.
+func TestAccResourcePlugingV1Replace(t *testing.T) {
+	tests := map[string]struct {
+		configCreate   string
+		configReplace  string
+		expState       expTestResourcePluginv1
+		expFileCreate  string
+		expFileReplace string
+		expFileContent string
+	}{
+		"Changing the path of the file should replace the resource.": {
+			configCreate: `
+resource "goplugin_plugin_v1" "test" {
+  plugin_id = "test_file"
+  attributes = jsonencode({
+    path = "/tmp/test.txt"
+    content = "this is a test"
+  })
+}
+`,
+
+			configReplace: `
+resource "goplugin_plugin_v1" "test" {
+  plugin_id = "test_file"
+  attributes = jsonencode({
+    path = "/tmp/test2.txt"
+    content = "this is a test"
+  })
+}
+`,
+			expState: expTestResourcePluginv1{
+				ID:         `test_file//tmp/test2.txt`,
+				ResourceID: `/tmp/test2.txt`,
+				PluginID:   `test_file`,
+				Attributes: `{"content":"this is a test","path":"/tmp/test2.txt"}`,
+			},
+			expFileCreate:  "/tmp/test.txt",
+			expFileReplace: "/tmp/test2.txt",
+			expFileContent: "this is a test",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Assemble our Terraform config code.
+			configCreate := testAccFilePluginConfig(test.configCreate)
+			configReplace := testAccFilePluginConfig(test.configReplace)
+
+			// Execute test.
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				CheckDestroy:             assertFileMissing(t, test.expFileReplace),
+				Steps: []resource.TestStep{
+					{
+						Config: configCreate,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							assertFileExistsWithContent(t, test.expFileCreate, test.expFileContent),
+						),
+					},
+					{
+						Config: configReplace,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "id", test.expState.ID),
+							resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "resource_id", test.expState.ResourceID),
+							resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "plugin_id", test.expState.PluginID),
+							resource.TestCheckResourceAttr("goplugin_plugin_v1.test", "attributes", test.expState.Attributes),
+							assertFileMissing(t, test.expFileCreate),
+							assertFileExistsWithContent(t, test.expFileReplace, test.expFileContent),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestPluginRequiresReplace(t *testing.T) {
+	tests := map[string]struct {
+		pluginPaths []string
+		expPaths    path.Paths
+		expDiags    diag.Diagnostics
+	}{
+		"Without plugin paths, it shouldn't require replacement.": {
+			pluginPaths: nil,
+			expPaths:    nil,
+			expDiags:    nil,
+		},
+
+		"Empty plugin paths shouldn't require replacement.": {
+			pluginPaths: []string{""},
+			expPaths:    nil,
+			expDiags:    nil,
+		},
+
+		"Plugin paths should require the replacement of the attributes and warn with the plugin paths.": {
+			pluginPaths: []string{"name", "", "spec.region", "name"},
+			expPaths:    path.Paths{path.Root("attributes")},
+			expDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("attributes"), "Plugin requires resource replacement",
+					`The resource will be replaced because these attributes can't be updated in place: "name", "spec.region"`),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			gotPaths, gotDiags := provider.PluginRequiresReplace(test.pluginPaths)
+			assert.Equal(test.expPaths, gotPaths)
+			assert.Equal(test.expDiags, gotDiags)
+		})
+	}
+}
+
+// TestAccResourcePlugingV1DeletedOutside will check a plugin resource deleted outside Terraform is recreated.
+// This test relies on a test plugin that knows how to manage a file a terraform resource.
+func TestAccResourcePlugingV1DeletedOutside(t *testing.T) {
+	tests := map[string]struct {
+		config         string
+		expFile        string
+		expFileContent string
+	}{
+		"A file deleted outside Terraform should be created again.": {
+			config: `
+resource "goplugin_plugin_v1" "test" {
+  plugin_id = "test_file"
+  attributes = jsonencode({
+    path = "/tmp/test.txt"
+    content = "this is a test"
+  })
+}
+`,
+			expFile:        "/tmp/test.txt",
+			expFileContent: "this is a test",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Assemble our Terraform config code.
+			config := testAccFilePluginConfig(test.config)
+
+			// Execute test.
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				CheckDestroy:             assertFileMissing(t, test.expFile),
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							assertFileExistsWithContent(t, test.expFile, test.expFileContent),
+						),
+					},
+					{
+						PreConfig: func() { _ = os.Remove(test.expFile) },
+						Config:    config,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							assertFileExistsWithContent(t, test.expFile, test.expFileContent),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestAccResourcePlugingV1AttributesEqual will check a plugin can compare the attributes semantically.
+// This test relies on a test plugin that knows how to manage a file a terraform resource.
+func TestAccResourcePlugingV1AttributesEqual(t *testing.T) {
+	tests := map[string]struct {
+		configCreate     string
+		configEquivalent string
+		expFile          string
+	}{
+		"Equivalent file paths should not have changes.": {
+			configCreate: `
+resource "goplugin_plugin_v1" "test" {
+  plugin_id = "test_file"
+  attributes = jsonencode({
+    path = "/tmp/test.txt"
+    content = "this is a test"
+  })
+}
+`,
+			configEquivalent: `
+resource "goplugin_plugin_v1" "test" {
+  plugin_id = "test_file"
+  attributes = jsonencode({
+    path = "/tmp//test.txt"
+    content = "this is a test"
+  })
+}
+`,
+			expFile: "/tmp/test.txt",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Assemble our Terraform config code.
+			configCreate := testAccFilePluginConfig(test.configCreate)
+			configEquivalent := testAccFilePluginConfig(test.configEquivalent)
+
+			// Execute test.
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				CheckDestroy:             assertFileMissing(t, test.expFile),
+				Steps: []resource.TestStep{
+					{
+						Config: configCreate,
+					},
+					{
+						Config:   configEquivalent,
+						PlanOnly: true,
+					},
+				},
+			})
+		})
+	}
+}
@@ -2,18 +2,155 @@ package tf
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
 
 	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
 )
 
-type plugin struct{}
+type plugin struct {
+	unhealthy bool
+}
+
+// readFailures tracks the failed reads per attributes to simulate eventual consistency.
+var (
+	readFailures   = map[string]int{}
+	readFailuresMu sync.Mutex
+)
+
+func Plugins() apiv1.PluginsRegistry {
+	return apiv1.PluginsRegistry{
+		DataSourcePlugins: map[string]apiv1.DataSourcePluginFactory{
+			"fake": NewDataSourcePlugin,
+		},
+	}
+}
 
 func NewDataSourcePlugin(config string) (apiv1.DataSourcePlugin, error) {
-	return plugin{}, nil
+	cfg := struct {
+		Unhealthy bool `json:"unhealthy"`
+	}{}
+	err := json.Unmarshal([]byte(config), &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal JSON configuration: %w", err)
+	}
+
+	return plugin{unhealthy: cfg.Unhealthy}, nil
 }
 
 func (p plugin) ReadDataSource(ctx context.Context, r apiv1.ReadDataSourceRequest) (*apiv1.ReadDataSourceResponse, error) {
+	att := map[string]interface{}{}
+	err := json.Unmarshal([]byte(r.Attributes), &att)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal JSON data: %w", err)
+	}
+
+	// Simulate a hung plugin that doesn't respect the context.
+	if sleep, ok := att["sleep"].(string); ok {
+		d, err := time.ParseDuration(sleep)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sleep: %w", err)
+		}
+		time.Sleep(d)
+	}
+
+	// Simulate a temporary error that will be fixed after some retries.
+	if failures, ok := att["retryable_failures"].(float64); ok {
+		readFailuresMu.Lock()
+		defer readFailuresMu.Unlock()
+		if readFailures[r.Attributes] < int(failures) {
+			readFailures[r.Attributes]++
+			return nil, apiv1.Retryable(fmt.Errorf("data source not available yet"))
+		}
+		delete(readFailures, r.Attributes)
+	}
+
+	// Return the body of the requested URL.
+	if url, ok := att["http_get"].(string); ok {
+		resp, err := http.Get(url)
+		if err != nil {
+			return nil, fmt.Errorf("could not get URL: %w", err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("could not read body: %w", err)
+		}
+		return &apiv1.ReadDataSourceResponse{Result: string(body)}, nil
+	}
+
+	// Return the content of the requested file.
+	if path, ok := att["read_file"].(string); ok {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read file: %w", err)
+		}
+		return &apiv1.ReadDataSourceResponse{Result: string(data)}, nil
+	}
+
+	// Return the process ID where the plugin is executed.
+	if pid, ok := att["pid"].(bool); ok && pid {
+		return &apiv1.ReadDataSourceResponse{Result: fmt.Sprint(os.Getpid())}, nil
+	}
+
+	// Simulate a plugin crash that can't be recovered.
+	if crash, ok := att["crash"].(bool); ok && crash {
+		done := make(chan struct{})
+		go func() { panic("crash") }()
+		<-done
+	}
+
+	// Return the requested env vars.
+	if names, ok := att["env"].([]interface{}); ok {
+		env := map[string]string{}
+		for _, name := range names {
+			env[fmt.Sprint(name)] = os.Getenv(fmt.Sprint(name))
+		}
+		result, err := json.Marshal(env)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal env: %w", err)
+		}
+		return &apiv1.ReadDataSourceResponse{Result: string(result)}, nil
+	}
+
 	return &apiv1.ReadDataSourceResponse{
 		Result: r.Attributes,
 	}, nil
 }
+
+func (p plugin) ValidateAttributes(ctx context.Context, r apiv1.ValidateAttributesRequest) (*apiv1.ValidateAttributesResponse, error) {
+	att := map[string]interface{}{}
+	err := json.Unmarshal([]byte(r.Attributes), &att)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal JSON data: %w", err)
+	}
+
+	resp := &apiv1.ValidateAttributesResponse{}
+	if _, ok := att["invalid"]; ok {
+		resp.Diagnostics = append(resp.Diagnostics, apiv1.Diagnostic{Summary: "Invalid attribute", Detail: "Attribute not supported", AttributePath: "invalid"})
+	}
+
+	return resp, nil
+}
+
+func (p plugin) HealthCheck(ctx context.Context, r apiv1.HealthCheckRequest) (*apiv1.HealthCheckResponse, error) {
+	if p.unhealthy {
+		return nil, fmt.Errorf("invalid credentials")
+	}
+
+	return &apiv1.HealthCheckResponse{}, nil
+}
+
+func (p plugin) Info() apiv1.PluginInfo {
+	return apiv1.PluginInfo{
+		Name:        "fake",
+		Version:     "v0.1.0",
+		Description: "Fake data source for testing",
+		DocsURL:     "https://github.com/slok/terraform-provider-goplugin",
+	}
+}
@@ -3,12 +3,31 @@ package tf
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
 )
 
+// private is the private data used to check the private state is stored by the provider.
+const private = "managed_by_file_plugin"
+
+type Outputs struct {
+	Size int `json:"size"`
+}
+
+func newOutputs(content string) (string, error) {
+	res, err := json.Marshal(Outputs{Size: len(content)})
+	if err != nil {
+		return "", fmt.Errorf("could not marshal into JSON: %w", err)
+	}
+
+	return string(res), nil
+}
+
 type Attributes struct {
 	Path    string `json:"path"`
 	Content string `json:"content"`
@@ -40,18 +59,36 @@ func (p plugin) CreateResource(ctx context.Context, r apiv1.CreateResourceReques
 		return nil, fmt.Errorf("invalid resource data: %w", err)
 	}
 
+	if !filepath.IsAbs(att.Path) {
+		return &apiv1.CreateResourceResponse{
+			Diagnostics: []apiv1.Diagnostic{
+				{Summary: "Invalid path", Detail: "Path must be absolute", AttributePath: "path"},
+			},
+		}, nil
+	}
+
 	err = os.WriteFile(att.Path, []byte(att.Content), 0644)
 	if err != nil {
 		return nil, fmt.Errorf("could not write file: %w", err)
 	}
 
+	outputs, err := newOutputs(att.Content)
+	if err != nil {
+		return nil, err
+	}
+
 	return &apiv1.CreateResourceResponse{
-		ID: att.Path,
+		ID:      att.Path,
+		Outputs: outputs,
+		Private: private,
 	}, nil
 }
 
 func (p plugin) ReadResource(ctx context.Context, r apiv1.ReadResourceRequest) (*apiv1.ReadResourceResponse, error) {
 	data, err := os.ReadFile(r.ID)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("file %q: %w", r.ID, apiv1.ErrNotFound)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("could not read file: %w", err)
 	}
@@ -64,8 +101,15 @@ func (p plugin) ReadResource(ctx context.Context, r apiv1.ReadResourceRequest) (
 		return nil, fmt.Errorf("could not marshal into JSON: %w", err)
 	}
 
+	outputs, err := newOutputs(string(data))
+	if err != nil {
+		return nil, err
+	}
+
 	return &apiv1.ReadResourceResponse{
 		Attributes: string(res),
+		Outputs:    outputs,
+		Private:    private,
 	}, nil
 }
 
@@ -74,7 +118,14 @@ func (p plugin) DeleteResource(ctx context.Context, r apiv1.DeleteResourceReques
 		return nil, fmt.Errorf("invalid path: %q", r.ID)
 	}
 
+	if r.Private != private {
+		return nil, fmt.Errorf("invalid private data: %q", r.Private)
+	}
+
 	err := os.Remove(r.ID)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("file %q: %w", r.ID, apiv1.ErrNotFound)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("could not delete file: %w", err)
 	}
@@ -94,5 +145,81 @@ func (p plugin) UpdateResource(ctx context.Context, r apiv1.UpdateResourceReques
 		return nil, fmt.Errorf("could not write file: %w", err)
 	}
 
-	return &apiv1.UpdateResourceResponse{}, nil
+	outputs, err := newOutputs(att.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UpdateResourceResponse{
+		Outputs: outputs,
+		Private: private,
+	}, nil
+}
+
+func (p plugin) ValidateAttributes(ctx context.Context, r apiv1.ValidateAttributesRequest) (*apiv1.ValidateAttributesResponse, error) {
+	att := Attributes{}
+	err := json.Unmarshal([]byte(r.Attributes), &att)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal JSON data: %w", err)
+	}
+
+	resp := &apiv1.ValidateAttributesResponse{}
+	if att.Path == "" {
+		resp.Diagnostics = append(resp.Diagnostics, apiv1.Diagnostic{Summary: "Missing path", Detail: "Path is required", AttributePath: "path"})
+	}
+
+	return resp, nil
+}
+
+func (p plugin) AttributesEqual(ctx context.Context, r apiv1.AttributesEqualRequest) (*apiv1.AttributesEqualResponse, error) {
+	att := Attributes{}
+	err := json.Unmarshal([]byte(r.Attributes), &att)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal JSON data: %w", err)
+	}
+
+	attState := Attributes{}
+	err = json.Unmarshal([]byte(r.AttributesState), &attState)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal JSON state data: %w", err)
+	}
+
+	// Different path representations of the same file are equal.
+	equal := filepath.Clean(att.Path) == filepath.Clean(attState.Path) && att.Content == attState.Content
+
+	return &apiv1.AttributesEqualResponse{Equal: equal}, nil
+}
+
+func (p plugin) PlanResource(ctx context.Context, r apiv1.PlanResourceRequest) (*apiv1.PlanResourceResponse, error) {
+	// Nothing to check on creation.
+	if r.AttributesState == "" {
+		return &apiv1.PlanResourceResponse{}, nil
+	}
+
+	att := Attributes{}
+	err := json.Unmarshal([]byte(r.Attributes), &att)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal JSON data: %w", err)
+	}
+
+	attState := Attributes{}
+	err = json.Unmarshal([]byte(r.AttributesState), &attState)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal JSON state data: %w", err)
+	}
+
+	// The path is the ID of the file, can't be updated.
+	resp := &apiv1.PlanResourceResponse{}
+	if att.Path != attState.Path {
+		resp.RequiresReplace = []string{"path"}
+	}
+
+	return resp, nil
+}
+
+func (p plugin) ImportResource(ctx context.Context, r apiv1.ImportResourceRequest) (*apiv1.ImportResourceResponse, error) {
+	// Support file URLs as import IDs.
+	return &apiv1.ImportResourceResponse{
+		ID: strings.TrimPrefix(r.ID, "file://"),
+	}, nil
 }
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	pluginv1 "github.com/slok/terraform-provider-goplugin/internal/plugin/v1"
+	apiv1 "github.com/slok/terraform-provider-goplugin/pkg/api/v1"
+)
+
+// validatePluginAttributes will validate the attributes using the plugin, validation is optional
+// so if the plugin doesn't implement the validation, it will be a noop. The validation uses the read
+// timeout and the retry policy of the plugin.
+func validatePluginAttributes(ctx context.Context, plugin interface{}, timeout time.Duration, retry pluginV1Retry, attributes string) diag.Diagnostics {
+	if !pluginv1.Supports(plugin, pluginv1.CapabilityAttributesValidator) {
+		return nil
+	}
+	validator := plugin.(apiv1.AttributesValidator)
+
+	diags := diag.Diagnostics{}
+	pluginResp, err := runPluginOperation(ctx, timeout, retry, func(ctx context.Context) (*apiv1.ValidateAttributesResponse, error) {
+		return validator.ValidateAttributes(ctx, apiv1.ValidateAttributesRequest{Attributes: attributes})
+	})
+	if err != nil {
+		diags.Append(pluginErrorDiagnostic(err))
+		return diags
+	}
+
+	diags.Append(pluginDiagnostics(pluginResp.Diagnostics)...)
+
+	return diags
+}
@@ -7,23 +7,45 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
+	pluginv1 "github.com/slok/terraform-provider-goplugin/internal/plugin/v1"
 	"github.com/slok/terraform-provider-goplugin/internal/provider"
 )
 
 const providerName = "registry.terraform.io/slok/goplugin"
 
+// Set on build time.
+var version = "dev"
+
 func run(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	err := providerserver.Serve(ctx, provider.New, providerserver.ServeOpts{
+	providerFactory, waitPluginsClose := provider.New(ctx, version)
+	err := providerserver.Serve(ctx, providerFactory, providerserver.ServeOpts{
 		Address: providerName,
 	})
 
-	return err
+	// Stop the provider and wait until the plugins are closed.
+	cancel()
+	closeErr := waitPluginsClose()
+	if err != nil {
+		return err
+	}
+
+	return closeErr
 }
 
 func main() {
+	// The provider binary is also the plugin process of the plugins using the process execution mode.
+	if pluginv1.IsPluginProcess() {
+		err := pluginv1.ServePluginProcess(context.Background())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error running plugin process: %s", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	err := run(context.Background())
 
 	if err != nil {
@@ -0,0 +1,8 @@
+package v1
+
+type contextKey int
+
+const (
+	loggerContextKey contextKey = iota
+	requestInfoContextKey
+)
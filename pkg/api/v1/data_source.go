@@ -12,6 +12,8 @@ type ReadDataSourceRequest struct {
 type ReadDataSourceResponse struct {
 	// Result is the result the plugin will return to Terraform.
 	Result string
+	// Diagnostics are optional messages that will be shown to the user, error diagnostics will fail the operation.
+	Diagnostics []Diagnostic
 }
 
 // DataSourcePlugin knows how to handle a Terraform data source by implementing gathering data operations.
@@ -0,0 +1,25 @@
+package v1
+
+// DiagnosticSeverity is the severity of a diagnostic.
+type DiagnosticSeverity int
+
+const (
+	// DiagnosticSeverityError will make the Terraform operation fail.
+	DiagnosticSeverityError DiagnosticSeverity = iota
+	// DiagnosticSeverityWarning will be shown to the user without failing the Terraform operation.
+	DiagnosticSeverityWarning
+)
+
+// Diagnostic is a message that the plugin returns to the Terraform user, these can be used to give
+// more detailed information than errors (e.g: deprecation warnings or messages about specific attributes).
+type Diagnostic struct {
+	// Severity of the diagnostic, by default error.
+	Severity DiagnosticSeverity
+	// Summary is a short description of the diagnostic.
+	Summary string
+	// Detail is the long description of the diagnostic.
+	Detail string
+	// AttributePath is an optional path of the attribute (inside the attributes JSON) that originated
+	// the diagnostic, using dots to access object keys and brackets for array indexes (e.g: `users[0].name`).
+	AttributePath string
+}
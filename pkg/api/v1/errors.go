@@ -0,0 +1,33 @@
+package v1
+
+import "errors"
+
+// ErrNotFound is the error that plugins should return (can be wrapped) when the resource doesn't exist.
+//
+// - On `ReadResource` the resource will be removed from the Terraform state, so Terraform can plan its creation again.
+// - On `DeleteResource` the resource will be treated as already deleted.
+var ErrNotFound = errors.New("not found")
+
+// ErrRetryable is the error that plugins should return (can be wrapped) when the operation failed with a temporary
+// error (e.g: eventual consistency, rate limits...), if the plugin has a retry policy configured on the provider, the
+// operation will be retried.
+//
+// Use `Retryable` to mark any error as retryable.
+var ErrRetryable = errors.New("retryable")
+
+// Retryable marks an error as retryable, the returned error will match `ErrRetryable` and the original error.
+func Retryable(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return retryableError{err: err}
+}
+
+type retryableError struct {
+	err error
+}
+
+func (r retryableError) Error() string        { return r.err.Error() }
+func (r retryableError) Unwrap() error        { return r.err }
+func (r retryableError) Is(target error) bool { return target == ErrRetryable }
@@ -0,0 +1,20 @@
+package v1
+
+// PluginInfo is the metadata of a plugin.
+type PluginInfo struct {
+	// Name is the name of the plugin.
+	Name string
+	// Version is the version of the plugin (e.g: `v1.2.0`).
+	Version string
+	// Description is a short description of the plugin.
+	Description string
+	// DocsURL is the URL of the plugin documentation.
+	DocsURL string
+}
+
+// InfoProvider is an optional interface that resource and data source plugins can implement to expose
+// their metadata, the metadata will be available on the `goplugin_plugins` data source.
+type InfoProvider interface {
+	// Info returns the metadata of the plugin.
+	Info() PluginInfo
+}
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+)
+
+// HealthCheckRequest is the request that the plugin will receive on the `HealthCheck` operation.
+type HealthCheckRequest struct{}
+
+// HealthCheckResponse is the response that the plugin will return after the `HealthCheck` operation.
+type HealthCheckResponse struct {
+	// Diagnostics are the messages that will be shown to the user, error diagnostics will fail the operation.
+	Diagnostics []Diagnostic
+}
+
+// HealthChecker is an optional interface that resource and data source plugins can implement to check
+// that the plugin is ready to be used (e.g: valid credentials, reachable APIs...), the check is executed
+// when the provider is configured, so the problems are reported before executing any operation.
+type HealthChecker interface {
+	// HealthCheck will be responsible of:
+	//
+	// - Checking the plugin dependencies are ready.
+	// - Returning an error (or error diagnostics) if the plugin can't be used.
+	HealthCheck(ctx context.Context, r HealthCheckRequest) (*HealthCheckResponse, error)
+}
+
+// Closer is an optional interface that resource and data source plugins can implement to release their
+// resources (e.g: connections, buffers...) when the provider stops.
+type Closer interface {
+	// Close will be responsible of releasing the plugin resources, the plugin will not be used after closing it.
+	Close(ctx context.Context) error
+}
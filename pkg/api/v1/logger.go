@@ -0,0 +1,44 @@
+package v1
+
+import "context"
+
+// Logger is the logger that plugins can use to log through Terraform, the logs will be
+// shown depending on the Terraform log level (e.g: `TF_LOG=debug`).
+//
+// Plugins can get the logger of the operation using `LoggerFromContext`.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Warningf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+	// WithValues returns a new logger with the values added as structured fields.
+	WithValues(values map[string]interface{}) Logger
+}
+
+// NoopLogger is a logger that doesn't log anything.
+var NoopLogger Logger = noopLogger(0)
+
+type noopLogger int
+
+func (noopLogger) Debugf(format string, args ...interface{})         {}
+func (noopLogger) Infof(format string, args ...interface{})          {}
+func (noopLogger) Warningf(format string, args ...interface{})       {}
+func (noopLogger) Errorf(format string, args ...interface{})         {}
+func (n noopLogger) WithValues(values map[string]interface{}) Logger { return n }
+
+// ContextWithLogger returns a new context with the logger, the provider sets the logger on every
+// plugin operation context, plugins don't need to use it except for testing purposes.
+func ContextWithLogger(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey, logger)
+}
+
+// LoggerFromContext returns the logger of the plugin operation, if the context doesn't have a
+// logger, it will return a `NoopLogger`.
+func LoggerFromContext(ctx context.Context) Logger {
+	logger, ok := ctx.Value(loggerContextKey).(Logger)
+	if !ok || logger == nil {
+		return NoopLogger
+	}
+
+	return logger
+}
@@ -0,0 +1,30 @@
+package v1
+
+// DefaultPluginsRegistryName is the default name used by the plugin engine to search for the plugins registry
+// on the plugin module source code.
+const DefaultPluginsRegistryName = "Plugins"
+
+// PluginsRegistry has the plugin factories of a plugin module by name, this way a single source code
+// module can register multiple resource and data source plugins.
+type PluginsRegistry struct {
+	// ResourcePlugins are the resource plugin factories by name.
+	ResourcePlugins map[string]ResourcePluginFactory
+	// DataSourcePlugins are the data source plugin factories by name.
+	DataSourcePlugins map[string]DataSourcePluginFactory
+}
+
+// PluginsRegistryFunc is the function type that the plugin engine will load and run to get the plugin
+// factories of a plugin module. E.g:
+//
+//	func Plugins() apiv1.PluginsRegistry {
+//		return apiv1.PluginsRegistry{
+//			ResourcePlugins: map[string]apiv1.ResourcePluginFactory{
+//				"user":  NewUserResourcePlugin,
+//				"group": NewGroupResourcePlugin,
+//			},
+//			DataSourcePlugins: map[string]apiv1.DataSourcePluginFactory{
+//				"user": NewUserDataSourcePlugin,
+//			},
+//		}
+//	}
+type PluginsRegistryFunc = func() PluginsRegistry
@@ -0,0 +1,48 @@
+package v1
+
+import "context"
+
+// Operations of the plugins.
+const (
+	OperationCreate      = "create"
+	OperationRead        = "read"
+	OperationUpdate      = "update"
+	OperationDelete      = "delete"
+	OperationPlan        = "plan"
+	OperationValidate    = "validate"
+	OperationImport      = "import"
+	OperationHealthCheck = "health_check"
+	OperationClose       = "close"
+)
+
+// RequestInfo is the information of the Terraform request that is executing the plugin operation,
+// plugins can use it for tagging, idempotency keys, audit trails...
+//
+// Plugins can get the request information of the operation using `RequestInfoFromContext`.
+type RequestInfo struct {
+	// PluginID is the ID of the plugin on the provider configuration.
+	PluginID string
+	// Operation is the operation being executed (e.g: `create`, `read`, `plan`...).
+	Operation string
+	// ResourceType is the Terraform resource or data source type (e.g: `goplugin_plugin_v1`).
+	ResourceType string
+	// ResourceID is the ID of the resource, empty if not known yet (e.g: on creation).
+	ResourceID string
+	// Workspace is the Terraform workspace from `TF_WORKSPACE` env var, empty if not set.
+	Workspace string
+	// ProviderVersion is the version of the provider.
+	ProviderVersion string
+}
+
+// ContextWithRequestInfo returns a new context with the request information, the provider sets the request
+// information on every plugin operation context, plugins don't need to use it except for testing purposes.
+func ContextWithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoContextKey, info)
+}
+
+// RequestInfoFromContext returns the request information of the plugin operation, if the context doesn't
+// have the request information, it will return an empty one.
+func RequestInfoFromContext(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoContextKey).(RequestInfo)
+	return info
+}
@@ -15,12 +15,29 @@ type CreateResourceRequest struct {
 type CreateResourceResponse struct {
 	// ID is the ID that tracks this resource.
 	ID string
+	// Outputs is a JSON string with the data generated by the resource creation (e.g: URLs, ARNs, timestamps...)
+	// that will be exposed in Terraform as computed data. Optional.
+	Outputs string
+	// Private is optional data that the plugin needs to track the resource and will not be shown to the user
+	// (e.g: ETags, API versions, internal handles...). It will be stored in the Terraform state and passed
+	// to the plugin on the next operations.
+	Private string
+	// Diagnostics are optional messages that will be shown to the user, error diagnostics will fail the operation.
+	Diagnostics []Diagnostic
 }
 
 // ReadResourceRequest is the request that the plugin will receive on resource `Read` operation.
 type ReadResourceRequest struct {
 	// ID is the ID that tracks this resource.
 	ID string
+	// AttributesState are the attributes used on the previous terraform apply execution.
+	//
+	// This field is meant to be used when the plugin can't read all the attributes from the resource
+	// (E.g: Partial reads of the keys managed by the user or write-only values like passwords).
+	// On import, there is no previous state so it will be empty.
+	AttributesState string
+	// Private is the private data returned by the plugin on previous operations.
+	Private string
 }
 
 // ReadResourceResponse is the response that the plugin will return after resource `Read` operation.
@@ -30,6 +47,15 @@ type ReadResourceResponse struct {
 	// On read operation normally this is used to refresh the state and check the attributes provided
 	// by the user match the ones that need to be read, then Terraform will watch for drifts.
 	Attributes string
+	// Outputs is a JSON string with the data generated by the resource (e.g: URLs, ARNs, timestamps...)
+	// that will be exposed in Terraform as computed data. Optional, if empty the previous outputs will be kept.
+	Outputs string
+	// Private is optional data that the plugin needs to track the resource and will not be shown to the user
+	// (e.g: ETags, API versions, internal handles...). It will be stored in the Terraform state and passed
+	// to the plugin on the next operations. If empty, the previous private data will be kept.
+	Private string
+	// Diagnostics are optional messages that will be shown to the user, error diagnostics will fail the operation.
+	Diagnostics []Diagnostic
 }
 
 // UpdateResourceRequest is the request that the plugin will receive on resource `Update` operation.
@@ -45,19 +71,38 @@ type UpdateResourceRequest struct {
 	// This field is meant to be used used when we want to make decisions based on previous terraform apply changes.
 	// (E.g: A resource data attribute can't be changed after the creation, so if changes we return an error)
 	AttributesState string
+	// Private is the private data returned by the plugin on previous operations.
+	Private string
 }
 
 // UpdateResourceResponse is the response that the plugin will return after resource `Update` operation.
-type UpdateResourceResponse struct{}
+type UpdateResourceResponse struct {
+	// Outputs is a JSON string with the data generated by the resource update (e.g: URLs, ARNs, timestamps...)
+	// that will be exposed in Terraform as computed data. Optional, if empty the previous outputs will be kept.
+	Outputs string
+	// Private is optional data that the plugin needs to track the resource and will not be shown to the user
+	// (e.g: ETags, API versions, internal handles...). It will be stored in the Terraform state and passed
+	// to the plugin on the next operations. If empty, the previous private data will be kept.
+	Private string
+	// Diagnostics are optional messages that will be shown to the user, error diagnostics will fail the operation.
+	Diagnostics []Diagnostic
+}
 
 // DeleteResourceRequest is the request that the plugin will receive on resource `Delete` operation.
 type DeleteResourceRequest struct {
 	// ID is the ID that tracks this resource.
 	ID string
+	// AttributesState are the attributes used on the previous terraform apply execution.
+	AttributesState string
+	// Private is the private data returned by the plugin on previous operations.
+	Private string
 }
 
 // DeleteResourceResponse is the response that the plugin will return after resource `Delete` operation.
-type DeleteResourceResponse struct{}
+type DeleteResourceResponse struct {
+	// Diagnostics are optional messages that will be shown to the user, error diagnostics will fail the operation.
+	Diagnostics []Diagnostic
+}
 
 // ResourcePlugin knows how to handle a Terraform resource by implementing the common Terraform CRUD operations.
 type ResourcePlugin interface {
@@ -85,6 +130,135 @@ type ResourcePlugin interface {
 	DeleteResource(ctx context.Context, r DeleteResourceRequest) (*DeleteResourceResponse, error)
 }
 
+// PlanResourceRequest is the request that the plugin will receive on resource plan phase.
+type PlanResourceRequest struct {
+	// ID is the ID that tracks this resource, empty when the resource is going to be created.
+	ID string
+	// Attributes are the proposed attributes, the data the Terraform user will provide in the configuration
+	// (tf files) to the plugin to manage the resource.
+	Attributes string
+	// AttributesState is the same as Attributes but instead of being the configuration from the user, are
+	// the attributes used on the previous terraform apply execution, empty when the resource is going to be
+	// created.
+	AttributesState string
+	// Private is the private data returned by the plugin on previous operations.
+	Private string
+}
+
+// PlanResourceResponse is the response that the plugin will return after resource plan phase.
+type PlanResourceResponse struct {
+	// Attributes are the normalized planned attributes, if empty the proposed attributes will be used.
+	//
+	// Terraform doesn't allow changing the user configuration on the plan, so if these attributes are
+	// equivalent to the attributes on the state, the state attributes will be planned (no changes), otherwise
+	// the proposed attributes will be planned.
+	Attributes string
+	// RequiresReplace are the JSON paths of the attributes (e.g: `name`, `spec.region`) that have changed and
+	// can't be updated in place. If any, the resource will be replaced (destroyed and created again) and the
+	// paths will be shown to the user as a warning.
+	RequiresReplace []string
+	// Diagnostics are optional messages that will be shown to the user, error diagnostics will fail the operation.
+	Diagnostics []Diagnostic
+}
+
+// ResourcePlanner is an optional interface that a ResourcePlugin can implement to shape the plan
+// before Terraform applies it.
+type ResourcePlanner interface {
+	// PlanResource will be responsible of:
+	//
+	// - Normalizing the proposed attributes.
+	// - Returning the changed attributes that require a resource replacement (e.g: immutable keys).
+	PlanResource(ctx context.Context, r PlanResourceRequest) (*PlanResourceResponse, error)
+}
+
+// AttributesEqualRequest is the request that the plugin will receive on the plan phase when the
+// attributes have changed.
+type AttributesEqualRequest struct {
+	// Attributes are the proposed attributes, the data the Terraform user will provide in the configuration
+	// (tf files) to the plugin to manage the resource.
+	Attributes string
+	// AttributesState are the attributes used on the previous terraform apply execution.
+	AttributesState string
+}
+
+// AttributesEqualResponse is the response that the plugin will return after comparing the attributes.
+type AttributesEqualResponse struct {
+	// Equal is true when the attributes are semantically equal, so there are no changes to apply.
+	Equal bool
+	// Diagnostics are optional messages that will be shown to the user, error diagnostics will fail the operation.
+	Diagnostics []Diagnostic
+}
+
+// AttributesComparator is an optional interface that a ResourcePlugin can implement to compare the attributes
+// semantically (e.g: case insensitive values, default fields, unordered arrays...) and avoid endless diffs.
+//
+// By default the attributes are compared as JSON, ignoring the key order and the whitespace.
+type AttributesComparator interface {
+	// AttributesEqual will be responsible of:
+	//
+	// - Returning if the proposed attributes and the state attributes are semantically equal.
+	AttributesEqual(ctx context.Context, r AttributesEqualRequest) (*AttributesEqualResponse, error)
+}
+
+// ImportResourceRequest is the request that the plugin will receive on resource import phase.
+type ImportResourceRequest struct {
+	// ID is the import ID provided by the user, without the `{plugin_id}/` prefix
+	// (e.g: `owner:name`, `{"owner":"x","name":"y"}`).
+	ID string
+}
+
+// ImportResourceResponse is the response that the plugin will return after resource import phase.
+type ImportResourceResponse struct {
+	// ID is the canonical ID that tracks this resource, the same as the one returned on creation.
+	ID string
+	// Attributes are the optional initial attributes of the imported resource, these will be passed
+	// as `AttributesState` on the read operation executed after the import.
+	Attributes string
+	// Diagnostics are optional messages that will be shown to the user, error diagnostics will fail the operation.
+	Diagnostics []Diagnostic
+}
+
+// ResourceImporter is an optional interface that a ResourcePlugin can implement to customize how
+// the resources are imported.
+type ResourceImporter interface {
+	// ImportResource will be responsible of:
+	//
+	// - Accepting user friendly import IDs (e.g: natural or composite keys).
+	// - Returning the canonical ID of the resource.
+	ImportResource(ctx context.Context, r ImportResourceRequest) (*ImportResourceResponse, error)
+}
+
+// UpgradeAttributesRequest is the request that the plugin will receive when the attributes on the Terraform
+// state are from an older attributes version.
+type UpgradeAttributesRequest struct {
+	// FromVersion is the attributes version of the attributes stored on the Terraform state.
+	FromVersion int
+	// Attributes are the attributes stored on the Terraform state using the `FromVersion` format.
+	Attributes string
+}
+
+// UpgradeAttributesResponse is the response that the plugin will return after upgrading the attributes.
+type UpgradeAttributesResponse struct {
+	// Attributes are the attributes upgraded to the current attributes version format.
+	Attributes string
+	// Diagnostics are optional messages that will be shown to the user, error diagnostics will fail the operation.
+	Diagnostics []Diagnostic
+}
+
+// AttributesUpgrader is an optional interface that a ResourcePlugin can implement to version the attributes
+// format and migrate the attributes stored on the Terraform state from older versions when the resource is
+// refreshed.
+type AttributesUpgrader interface {
+	// AttributesVersion returns the current attributes version, plugins not implementing
+	// this interface are considered version 0.
+	AttributesVersion() int
+
+	// UpgradeAttributes will be responsible of:
+	//
+	// - Migrating the attributes from an older version to the current version format.
+	UpgradeAttributes(ctx context.Context, r UpgradeAttributesRequest) (*UpgradeAttributesResponse, error)
+}
+
 // DefaultResourcePluginFactoryName is the default name used by the plugin engine to search for the plugin factory
 // on the plugin source code.
 const DefaultResourcePluginFactoryName = "NewResourcePlugin"
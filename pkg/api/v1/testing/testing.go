@@ -56,6 +56,9 @@ func NewTestResourcePlugin(ctx context.Context, config TestResourcePluginConfig)
 		SourceCodeRepository: repo,
 		PluginFactoryName:    config.PluginFactoryName,
 		PluginOptions:        config.PluginConfiguration,
+		// Show the plugin output on the tests.
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
 	})
 }
 
@@ -105,5 +108,8 @@ func NewTestDataSourcePlugin(ctx context.Context, config TestDataSourcePluginCon
 		SourceCodeRepository: repo,
 		PluginFactoryName:    config.PluginFactoryName,
 		PluginOptions:        config.PluginConfiguration,
+		// Show the plugin output on the tests.
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
 	})
 }
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+)
+
+// ValidateAttributesRequest is the request that the plugin will receive on the `Validate` operation.
+type ValidateAttributesRequest struct {
+	// Attributes is the data the Terraform user will provide in the configuration (tf files) to the
+	// plugin.
+	Attributes string
+}
+
+// ValidateAttributesResponse is the response that the plugin will return after the `Validate` operation.
+type ValidateAttributesResponse struct {
+	// Diagnostics are the validation messages that will be shown to the user, error diagnostics will fail the operation.
+	Diagnostics []Diagnostic
+}
+
+// AttributesValidator is an optional interface that resource and data source plugins can implement to validate
+// the attributes before executing any operation, this way invalid attributes will fail at plan time instead
+// of apply time.
+type AttributesValidator interface {
+	// ValidateAttributes will be responsible of:
+	//
+	// - Validating the attributes provided by the user.
+	// - Returning diagnostics for the invalid attributes.
+	ValidateAttributes(ctx context.Context, r ValidateAttributesRequest) (*ValidateAttributesResponse, error)
+}